@@ -0,0 +1,86 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"flag"
+	"io/ioutil"
+	"os"
+
+	"github.com/kormat/eve-overview-tool/overview"
+)
+
+var annotateCmd = &command{
+	name:  "annotate",
+	args:  "FILE",
+	short: "Write FILE with every ID annotated with its description.",
+	setup: func(fs *flag.FlagSet) func([]string) error {
+		var cf catalogFlags
+		var pf parseFlags
+		var of outputFlags
+		var af allGroupsFlags
+		cf.register(fs)
+		pf.register(fs)
+		of.register(fs)
+		af.register(fs)
+		out := fs.String("o", "", "Write the output to this file instead of stdout")
+		legacy := fs.String("f", "", "Overview file to annotate (deprecated, pass FILE instead)")
+		describe := fs.Bool("describe-groups", false,
+			"Add a comment above the groups of each preset describing them by category")
+		return func(args []string) error {
+			if *legacy != "" {
+				args = append([]string{*legacy}, args...)
+			}
+			name, err := oneFile(args)
+			if err != nil {
+				return err
+			}
+			opts, err := of.options(true)
+			if err != nil {
+				return err
+			}
+			cat, err := cf.load()
+			if err != nil {
+				return err
+			}
+			o, err := pf.load(name)
+			if err != nil {
+				return err
+			}
+			diags := overview.Validate(o, cat)
+			logDiags(diags)
+			if *describe {
+				if opts.DescribeGroups, err = af.load(cat); err != nil {
+					return err
+				}
+			}
+			b, err := overview.MarshalWithOptions(o, cat, opts)
+			if err != nil {
+				return err
+			}
+			if *out != "" {
+				err = ioutil.WriteFile(*out, b, 0644)
+			} else {
+				_, err = os.Stdout.Write(b)
+			}
+			if err == nil && diags.HasErrors() {
+				// The annotated file is still written, as it helps with
+				// finding the problems.
+				return errSilent
+			}
+			return err
+		}
+	},
+}
@@ -0,0 +1,89 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"github.com/kormat/eve-overview-tool/overview"
+)
+
+var compileCmd = &command{
+	name:  "compile",
+	args:  "FILE SPEC",
+	short: "Set the groups of presets from category-relative descriptions in SPEC.",
+	setup: func(fs *flag.FlagSet) func([]string) error {
+		var cf catalogFlags
+		var pf parseFlags
+		var of outputFlags
+		var wf writeFlags
+		var af allGroupsFlags
+		cf.register(fs)
+		pf.register(fs)
+		of.register(fs)
+		wf.register(fs)
+		af.register(fs)
+		return func(args []string) error {
+			if len(args) != 2 {
+				return usagef("expected an overview file and a spec file")
+			}
+			if err := wf.check(); err != nil {
+				return err
+			}
+			opts, err := of.options(true)
+			if err != nil {
+				return err
+			}
+			cat, err := cf.load()
+			if err != nil {
+				return err
+			}
+			o, err := pf.load(args[0])
+			if err != nil {
+				return err
+			}
+			b, err := ioutil.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+			specs, err := overview.ParsePresetSpecs(b, cat)
+			if err != nil {
+				return fmt.Errorf("%s: %s", args[1], err)
+			}
+			ag, err := af.load(cat)
+			if err != nil {
+				return err
+			}
+			for _, ps := range specs {
+				igs := ps.Groups.Compile(ag)
+				if p := o.Preset(ps.Name); p != nil {
+					p.Groups = &overview.PresetGroups{Groups: igs}
+					continue
+				}
+				if err := o.SetPreset(ps.Name, overview.GroupSet(igs), false); err != nil {
+					return err
+				}
+				log.Printf("NOTE: added preset %+q", ps.Name)
+			}
+			if b, err = overview.MarshalWithOptions(o, cat, opts); err != nil {
+				return err
+			}
+			return wf.write(args[0], b)
+		}
+	},
+}
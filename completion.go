@@ -0,0 +1,210 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+)
+
+var completionCmd = &command{
+	name:  "completion",
+	args:  "bash|zsh|fish",
+	short: "Print a shell completion script.",
+	setup: func(fs *flag.FlagSet) func([]string) error {
+		return func(args []string) error {
+			if len(args) != 1 {
+				return usagef("expected a shell name")
+			}
+			var gen func(*bytes.Buffer)
+			switch args[0] {
+			case "bash":
+				gen = bashCompletion
+			case "zsh":
+				gen = zshCompletion
+			case "fish":
+				gen = fishCompletion
+			default:
+				return usagef("unsupported shell %+q", args[0])
+			}
+			var buf bytes.Buffer
+			gen(&buf)
+			_, err := os.Stdout.Write(buf.Bytes())
+			return err
+		}
+	},
+}
+
+// flagInfo describes a command's flag, for completion.
+type flagInfo struct {
+	name  string
+	usage string
+	// isBool is true if the flag takes no value.
+	isBool bool
+}
+
+// isFile returns true if the flag's value is a file or directory name.
+func (fi flagInfo) isFile() bool {
+	u := strings.ToLower(fi.usage)
+	return strings.Contains(u, "file") || strings.Contains(u, "directory")
+}
+
+// commandFlags returns the flags registered by cmd.
+func commandFlags(cmd *command) []flagInfo {
+	fs := flag.NewFlagSet(cmd.name, flag.ContinueOnError)
+	cmd.setup(fs)
+	var out []flagInfo
+	fs.VisitAll(func(f *flag.Flag) {
+		bf, ok := f.Value.(interface{ IsBoolFlag() bool })
+		out = append(out, flagInfo{name: f.Name, usage: f.Usage, isBool: ok && bf.IsBoolFlag()})
+	})
+	return out
+}
+
+// argWords returns the fixed set of words that cmd's positional arguments are
+// taken from, or nil if they are file names.
+func argWords(cmd *command) []string {
+	// Matched by name, as the commands refer to this function.
+	switch cmd.name {
+	case "help":
+		return commandNames()
+	case "completion":
+		return []string{"bash", "zsh", "fish"}
+	}
+	return nil
+}
+
+func commandNames() []string {
+	var out []string
+	for _, cmd := range commands {
+		out = append(out, cmd.names()...)
+	}
+	return out
+}
+
+func bashCompletion(buf *bytes.Buffer) {
+	buf.WriteString("# bash completion for eve-overview-tool\n")
+	buf.WriteString("_eve_overview_tool() {\n")
+	buf.WriteString("    local cur=\"${COMP_WORDS[COMP_CWORD]}\"\n")
+	buf.WriteString("    if [ \"$COMP_CWORD\" -eq 1 ]; then\n")
+	fmt.Fprintf(buf, "        COMPREPLY=($(compgen -W %+q -- \"$cur\"))\n", strings.Join(commandNames(), " "))
+	buf.WriteString("        return\n    fi\n")
+	buf.WriteString("    local flags=\"\"\n")
+	buf.WriteString("    case \"${COMP_WORDS[1]}\" in\n")
+	for _, cmd := range commands {
+		var names []string
+		for _, f := range commandFlags(cmd) {
+			names = append(names, "-"+f.name)
+		}
+		if words := argWords(cmd); words != nil {
+			names = words
+		}
+		fmt.Fprintf(buf, "    %s) flags=%+q ;;\n", strings.Join(cmd.names(), "|"), strings.Join(names, " "))
+	}
+	buf.WriteString("    esac\n")
+	buf.WriteString("    if [[ \"$cur\" == -* || \"${COMP_WORDS[1]}\" =~ ^(help|completion)$ ]]; then\n")
+	buf.WriteString("        COMPREPLY=($(compgen -W \"$flags\" -- \"$cur\"))\n")
+	buf.WriteString("    else\n")
+	buf.WriteString("        COMPREPLY=($(compgen -f -- \"$cur\"))\n")
+	buf.WriteString("    fi\n")
+	buf.WriteString("}\n")
+	buf.WriteString("complete -o filenames -F _eve_overview_tool eve-overview-tool\n")
+}
+
+func zshCompletion(buf *bytes.Buffer) {
+	buf.WriteString("#compdef eve-overview-tool\n\n")
+	buf.WriteString("_eve_overview_tool() {\n")
+	buf.WriteString("    local -a commands\n    commands=(\n")
+	for _, cmd := range commands {
+		for _, name := range cmd.names() {
+			fmt.Fprintf(buf, "        %s\n", zshQuote(name+":"+cmd.short))
+		}
+	}
+	buf.WriteString("    )\n")
+	buf.WriteString("    if (( CURRENT == 2 )); then\n")
+	buf.WriteString("        _describe 'command' commands\n        return\n    fi\n")
+	buf.WriteString("    case \"$words[2]\" in\n")
+	for _, cmd := range commands {
+		fmt.Fprintf(buf, "    %s)\n        _arguments \\\n", strings.Join(cmd.names(), "|"))
+		for _, f := range commandFlags(cmd) {
+			spec := "-" + f.name + "[" + zshEscape(f.usage) + "]"
+			switch {
+			case f.isBool:
+			case f.isFile():
+				spec += ":" + f.name + ":_files"
+			default:
+				spec += ":" + f.name + ": "
+			}
+			fmt.Fprintf(buf, "            %s \\\n", zshQuote(spec))
+		}
+		if words := argWords(cmd); words != nil {
+			buf.WriteString("            '1:argument:(" + strings.Join(words, " ") + ")'\n")
+		} else {
+			buf.WriteString("            '*:file:_files'\n")
+		}
+		buf.WriteString("        ;;\n")
+	}
+	buf.WriteString("    esac\n}\n\n")
+	buf.WriteString("compdef _eve_overview_tool eve-overview-tool\n")
+}
+
+// zshEscape escapes the characters that are special inside an _arguments
+// description.
+func zshEscape(s string) string {
+	return strings.NewReplacer("[", "\\[", "]", "\\]", ":", "\\:").Replace(s)
+}
+
+func zshQuote(s string) string {
+	return "'" + strings.Replace(s, "'", "'\\''", -1) + "'"
+}
+
+func fishCompletion(buf *bytes.Buffer) {
+	buf.WriteString("# fish completion for eve-overview-tool\n")
+	buf.WriteString("complete -c eve-overview-tool -f\n")
+	for _, cmd := range commands {
+		for _, name := range cmd.names() {
+			fmt.Fprintf(buf, "complete -c eve-overview-tool -n __fish_use_subcommand -a %s -d %s\n",
+				name, fishQuote(cmd.short))
+		}
+	}
+	for _, cmd := range commands {
+		cond := "__fish_seen_subcommand_from " + strings.Join(cmd.names(), " ")
+		for _, f := range commandFlags(cmd) {
+			line := fmt.Sprintf("complete -c eve-overview-tool -n %s -o %s -d %s",
+				fishQuote(cond), f.name, fishQuote(f.usage))
+			switch {
+			case f.isBool:
+			case f.isFile():
+				line += " -r -F"
+			default:
+				line += " -r"
+			}
+			buf.WriteString(line + "\n")
+		}
+		if words := argWords(cmd); words != nil {
+			fmt.Fprintf(buf, "complete -c eve-overview-tool -n %s -a %s\n",
+				fishQuote(cond), fishQuote(strings.Join(words, " ")))
+		} else if cmd.args != "" {
+			fmt.Fprintf(buf, "complete -c eve-overview-tool -n %s -F\n", fishQuote(cond))
+		}
+	}
+}
+
+func fishQuote(s string) string {
+	return "'" + strings.NewReplacer("\\", "\\\\", "'", "\\'").Replace(s) + "'"
+}
@@ -0,0 +1,127 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/kormat/eve-overview-tool/overview"
+)
+
+var composeCmd = &command{
+	name:  "compose",
+	args:  "FILE OPERAND [+|-|& OPERAND]...",
+	short: "Create a preset from the union, intersection or difference of others.",
+	setup: func(fs *flag.FlagSet) func([]string) error {
+		var cf catalogFlags
+		var pf parseFlags
+		var of outputFlags
+		cf.register(fs)
+		pf.register(fs)
+		of.register(fs)
+		name := fs.String("name", "", "Name of the preset to create (required)")
+		replace := fs.Bool("replace", false, "Replace the preset if it already exists")
+		var wf writeFlags
+		wf.register(fs)
+		return func(args []string) error {
+			if len(args) < 2 {
+				return usagef("expected an overview file and an expression")
+			}
+			if *name == "" {
+				return usagef("-name is required")
+			}
+			if err := wf.check(); err != nil {
+				return err
+			}
+			opts, err := of.options(true)
+			if err != nil {
+				return err
+			}
+			cat, err := cf.load()
+			if err != nil {
+				return err
+			}
+			o, err := pf.load(args[0])
+			if err != nil {
+				return err
+			}
+			ps, err := evalCompose(o, args[1:])
+			if err != nil {
+				return err
+			}
+			if err := o.SetPreset(*name, ps, *replace); err != nil {
+				return fmt.Errorf("%s (use -replace to overwrite it)", err)
+			}
+			b, err := overview.MarshalWithOptions(o, cat, opts)
+			if err != nil {
+				return err
+			}
+			return wf.write(args[0], b)
+		}
+	},
+}
+
+// evalCompose evaluates a preset expression from left to right, e.g.
+// "pvp - groups/drone.yaml + groups/celestial.yaml".
+func evalCompose(o *overview.Overview, expr []string) (*overview.PresetSet, error) {
+	if len(expr)%2 == 0 {
+		return nil, usagef("incomplete expression %+q", strings.Join(expr, " "))
+	}
+	ps, err := composeOperand(o, expr[0])
+	if err != nil {
+		return nil, err
+	}
+	for i := 1; i < len(expr); i += 2 {
+		rhs, err := composeOperand(o, expr[i+1])
+		if err != nil {
+			return nil, err
+		}
+		switch expr[i] {
+		case "+":
+			ps = ps.Union(rhs)
+		case "&":
+			ps = ps.Intersect(rhs)
+		case "-":
+			ps = ps.Difference(rhs)
+		default:
+			return nil, usagef("unknown operator %+q, expected +, - or &", expr[i])
+		}
+	}
+	return ps, nil
+}
+
+// composeOperand returns the groups and states of a preset in o, or the
+// groups in a group list file (e.g. groups/drone.yaml).
+func composeOperand(o *overview.Overview, s string) (*overview.PresetSet, error) {
+	if strings.HasSuffix(s, ".yaml") {
+		b, err := ioutil.ReadFile(s)
+		if err != nil {
+			return nil, err
+		}
+		igs, err := overview.ParseGroupSnippet(b)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", s, err)
+		}
+		return overview.GroupSet(igs), nil
+	}
+	p := o.Preset(s)
+	if p == nil {
+		return nil, fmt.Errorf("no preset named %+q", s)
+	}
+	return overview.PresetSetOf(p), nil
+}
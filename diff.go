@@ -0,0 +1,290 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/kormat/eve-overview-tool/overview"
+)
+
+var diffCmd = &command{
+	name:  "diff",
+	args:  "OLD NEW",
+	short: "Show the semantic differences between two overview files.",
+	setup: func(fs *flag.FlagSet) func([]string) error {
+		var cf catalogFlags
+		var pf parseFlags
+		cf.register(fs)
+		pf.register(fs)
+		format := fs.String("format", "text", "Output format: 'text', 'json' or 'markdown'")
+		exitCode := fs.Bool("exit-code", false, "Exit with 1 if there are differences")
+		return func(args []string) error {
+			if len(args) != 2 {
+				return usagef("expected 2 overview files, got %d arguments", len(args))
+			}
+			var write func(io.Writer, *overview.Delta) error
+			switch *format {
+			case "text":
+				write = writeDiffText
+			case "json":
+				write = writeDiffJSON
+			case "markdown", "md":
+				write = writeDiffMarkdown
+			default:
+				return usagef("unknown format %+q", *format)
+			}
+			cat, err := cf.load()
+			if err != nil {
+				return err
+			}
+			a, err := pf.load(args[0])
+			if err != nil {
+				return err
+			}
+			b, err := pf.load(args[1])
+			if err != nil {
+				return err
+			}
+			d := overview.Diff(a, b, cat)
+			if err := write(os.Stdout, d); err != nil {
+				return err
+			}
+			if *exitCode && !d.Empty() {
+				return errSilent
+			}
+			return nil
+		}
+	},
+}
+
+// listSection is a list-valued section of a diff.
+type listSection struct {
+	name string
+	ld   *overview.ListDelta
+}
+
+// entrySection is a section of a diff made up of keyed entries.
+type entrySection struct {
+	name string
+	eds  []*overview.EntryDelta
+}
+
+func diffSections(d *overview.Delta) ([]entrySection, []listSection) {
+	entries := []entrySection{
+		{"tabSetup", d.TabSetup},
+		{"shipLabels", d.ShipLabels},
+		{"stateColorsNameList", d.StateColors},
+		{"stateBlinks", d.StateBlinks},
+		{"userSettings", d.UserSettings},
+	}
+	lists := []listSection{
+		{"shipLabelOrder", d.ShipLabelOrder},
+		{"columnOrder", d.ColumnOrder},
+		{"overviewColumns", d.OverviewColumns},
+		{"backgroundOrder", d.BackgroundOrder},
+		{"backgroundStates", d.BackgroundStates},
+		{"flagOrder", d.FlagOrder},
+		{"flagStates", d.FlagStates},
+	}
+	return entries, lists
+}
+
+func presetLists(pd *overview.PresetDelta) []listSection {
+	return []listSection{
+		{"groups", pd.Groups},
+		{"alwaysShownStates", pd.AlwaysShownStates},
+		{"filteredStates", pd.FilteredStates},
+	}
+}
+
+func formatItem(it overview.Item) string {
+	if it.Name == "" {
+		return fmt.Sprint(it.ID)
+	}
+	return fmt.Sprintf("%v # %s", it.ID, it.Name)
+}
+
+func formatValue(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return "(unset)"
+	case string:
+		return fmt.Sprintf("%+q", v)
+	}
+	return fmt.Sprint(v)
+}
+
+func itemIDs(its []overview.Item) string {
+	ids := make([]string, len(its))
+	for i, it := range its {
+		ids[i] = fmt.Sprint(it.ID)
+	}
+	return strings.Join(ids, ", ")
+}
+
+func entryTitle(ed *overview.EntryDelta) string {
+	if ed.Desc == "" {
+		return ed.Key
+	}
+	return fmt.Sprintf("%s (%s)", ed.Key, ed.Desc)
+}
+
+func writeDiffJSON(w io.Writer, d *overview.Delta) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(d)
+}
+
+func writeDiffText(w io.Writer, d *overview.Delta) error {
+	if d.Empty() {
+		return nil
+	}
+	writeList := func(indent string, ls listSection) {
+		if ls.ld == nil {
+			return
+		}
+		suffix := ""
+		if ls.ld.Reordered {
+			suffix = " (reordered)"
+		}
+		fmt.Fprintf(w, "%s%s%s:\n", indent, ls.name, suffix)
+		for _, it := range ls.ld.Added {
+			fmt.Fprintf(w, "%s  + %s\n", indent, formatItem(it))
+		}
+		for _, it := range ls.ld.Removed {
+			fmt.Fprintf(w, "%s  - %s\n", indent, formatItem(it))
+		}
+		if ls.ld.Reordered {
+			fmt.Fprintf(w, "%s  old: %s\n", indent, itemIDs(ls.ld.Old))
+			fmt.Fprintf(w, "%s  new: %s\n", indent, itemIDs(ls.ld.New))
+		}
+	}
+	writeAttrs := func(indent string, acs []overview.AttrChange) {
+		for _, ac := range acs {
+			fmt.Fprintf(w, "%s%s: %s -> %s\n", indent, ac.Name, formatValue(ac.Old), formatValue(ac.New))
+		}
+	}
+	if len(d.Presets) > 0 {
+		fmt.Fprintf(w, "presets:\n")
+		for _, pd := range d.Presets {
+			fmt.Fprintf(w, "  %+q %s\n", pd.Name, pd.Status)
+			for _, ls := range presetLists(pd) {
+				writeList("    ", ls)
+			}
+			writeAttrs("    ", pd.Extra)
+		}
+	}
+	entries, lists := diffSections(d)
+	for _, es := range entries {
+		if len(es.eds) == 0 {
+			continue
+		}
+		fmt.Fprintf(w, "%s:\n", es.name)
+		for _, ed := range es.eds {
+			fmt.Fprintf(w, "  %s %s\n", entryTitle(ed), ed.Status)
+			writeAttrs("    ", ed.Attrs)
+		}
+	}
+	for _, ls := range lists {
+		writeList("", ls)
+	}
+	if len(d.Extra) > 0 {
+		fmt.Fprintf(w, "other sections:\n")
+		writeAttrs("  ", d.Extra)
+	}
+	return nil
+}
+
+func writeDiffMarkdown(w io.Writer, d *overview.Delta) error {
+	if d.Empty() {
+		fmt.Fprintf(w, "No differences.\n")
+		return nil
+	}
+	code := func(v interface{}) string {
+		s := formatValue(v)
+		return "`" + strings.Replace(s, "|", "\\|", -1) + "`"
+	}
+	writeList := func(ls listSection) {
+		if ls.ld == nil {
+			return
+		}
+		for _, it := range ls.ld.Added {
+			fmt.Fprintf(w, "- Added %s `%v` %s\n", ls.name, it.ID, it.Name)
+		}
+		for _, it := range ls.ld.Removed {
+			fmt.Fprintf(w, "- Removed %s `%v` %s\n", ls.name, it.ID, it.Name)
+		}
+		if ls.ld.Reordered {
+			fmt.Fprintf(w, "- Reordered %s from `%s` to `%s`\n", ls.name,
+				itemIDs(ls.ld.Old), itemIDs(ls.ld.New))
+		}
+	}
+	writeAttrs := func(title string, acs []overview.AttrChange) {
+		for _, ac := range acs {
+			fmt.Fprintf(w, "| %s | %s | %s | %s |\n", title, ac.Name, code(ac.Old), code(ac.New))
+		}
+	}
+	if len(d.Presets) > 0 {
+		fmt.Fprintf(w, "## Presets\n")
+		for _, pd := range d.Presets {
+			fmt.Fprintf(w, "\n### %s (%s)\n\n", pd.Name, pd.Status)
+			for _, ls := range presetLists(pd) {
+				writeList(ls)
+			}
+			if len(pd.Extra) > 0 {
+				fmt.Fprintf(w, "\n| Preset | Attribute | Old | New |\n|---|---|---|---|\n")
+				writeAttrs(pd.Name, pd.Extra)
+			}
+		}
+		fmt.Fprintf(w, "\n")
+	}
+	entries, lists := diffSections(d)
+	for _, es := range entries {
+		if len(es.eds) == 0 {
+			continue
+		}
+		fmt.Fprintf(w, "## %s\n\n| Entry | Attribute | Old | New |\n|---|---|---|---|\n", es.name)
+		for _, ed := range es.eds {
+			writeAttrs(fmt.Sprintf("%s (%s)", entryTitle(ed), ed.Status), ed.Attrs)
+		}
+		fmt.Fprintf(w, "\n")
+	}
+	var changed []listSection
+	for _, ls := range lists {
+		if ls.ld != nil {
+			changed = append(changed, ls)
+		}
+	}
+	if len(changed) > 0 {
+		fmt.Fprintf(w, "## Lists\n\n")
+		for _, ls := range changed {
+			writeList(ls)
+		}
+		fmt.Fprintf(w, "\n")
+	}
+	if len(d.Extra) > 0 {
+		fmt.Fprintf(w, "## Other sections\n\n| Section | Old | New |\n|---|---|---|\n")
+		for _, ac := range d.Extra {
+			fmt.Fprintf(w, "| %s | %s | %s |\n", ac.Name, code(ac.Old), code(ac.New))
+		}
+	}
+	return nil
+}
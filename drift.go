@@ -0,0 +1,122 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/kormat/eve-overview-tool/overview"
+)
+
+var driftCmd = &command{
+	name:  "drift",
+	args:  "FILE",
+	short: "Find presets missing groups added to the game since they were made.",
+	setup: func(fs *flag.FlagSet) func([]string) error {
+		var cf catalogFlags
+		var pf parseFlags
+		var of outputFlags
+		var wf writeFlags
+		var af allGroupsFlags
+		cf.register(fs)
+		pf.register(fs)
+		of.register(fs)
+		wf.register(fs)
+		af.register(fs)
+		threshold := fs.Float64("threshold", 0.8,
+			"Fraction of a category a preset must include for missing groups to be reported")
+		add := fs.Bool("add", false, "Add the new groups to the presets, and write the result")
+		jsonOut := fs.Bool("json", false, "Write the report as JSON")
+		return func(args []string) error {
+			name, err := oneFile(args)
+			if err != nil {
+				return err
+			}
+			if err := wf.check(); err != nil {
+				return err
+			}
+			if *threshold < 0 || *threshold > 1 {
+				return usagef("-threshold must be between 0 and 1")
+			}
+			opts, err := of.options(true)
+			if err != nil {
+				return err
+			}
+			cat, err := cf.load()
+			if err != nil {
+				return err
+			}
+			o, err := pf.load(name)
+			if err != nil {
+				return err
+			}
+			ag, err := af.load(cat)
+			if err != nil {
+				return err
+			}
+			gds := overview.Drift(o, cat, ag, *threshold)
+			w := os.Stdout
+			if *add {
+				o.AddNewGroups(gds)
+				b, err := overview.MarshalWithOptions(o, cat, opts)
+				if err != nil {
+					return err
+				}
+				if err := wf.write(name, b); err != nil {
+					return err
+				}
+				// Keep the report out of the overview, if that's on stdout.
+				if wf.toStdout() {
+					w = os.Stderr
+				}
+			}
+			if *jsonOut {
+				enc := json.NewEncoder(w)
+				enc.SetIndent("", "  ")
+				if err := enc.Encode(gds); err != nil {
+					return err
+				}
+			} else {
+				writeDrift(w, gds, *add)
+			}
+			if len(gds) > 0 && !*add {
+				return errSilent
+			}
+			return nil
+		}
+	},
+}
+
+// writeDrift writes the categories each preset is missing new groups from.
+func writeDrift(w io.Writer, gds []*overview.GroupDrift, added bool) {
+	preset := ""
+	for i, gd := range gds {
+		if i == 0 || gd.Preset != preset {
+			preset = gd.Preset
+			fmt.Fprintf(w, "%s:\n", preset)
+		}
+		cc := gd.Category
+		verb := "missing"
+		if added {
+			verb = "added"
+		}
+		fmt.Fprintf(w, "  %s (%d): %d of %d groups, %s %s\n", cc.Name, int(cc.ID),
+			len(cc.Included), cc.Total, verb, englishList(names(gd.New)))
+	}
+}
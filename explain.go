@@ -0,0 +1,178 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/kormat/eve-overview-tool/overview"
+)
+
+var explainCmd = &command{
+	name:  "explain",
+	args:  "FILE [PRESET...]",
+	short: "Describe what presets show in plain English.",
+	setup: func(fs *flag.FlagSet) func([]string) error {
+		var cf catalogFlags
+		var pf parseFlags
+		var af allGroupsFlags
+		cf.register(fs)
+		pf.register(fs)
+		af.register(fs)
+		jsonOut := fs.Bool("json", false, "Write the explanations as JSON")
+		return func(args []string) error {
+			if len(args) < 1 {
+				return usagef("expected an overview file")
+			}
+			cat, err := cf.load()
+			if err != nil {
+				return err
+			}
+			o, err := pf.load(args[0])
+			if err != nil {
+				return err
+			}
+			ag, err := af.load(cat)
+			if err != nil {
+				return err
+			}
+			names := args[1:]
+			if len(names) == 0 {
+				for _, p := range o.Presets {
+					names = append(names, p.Name)
+				}
+			}
+			var pes []*overview.PresetExplanation
+			for _, name := range names {
+				pe, err := overview.ExplainPreset(o, cat, ag, name)
+				if err != nil {
+					return err
+				}
+				pes = append(pes, pe)
+			}
+			if *jsonOut {
+				enc := json.NewEncoder(os.Stdout)
+				enc.SetIndent("", "  ")
+				return enc.Encode(pes)
+			}
+			for i, pe := range pes {
+				if i > 0 {
+					fmt.Println()
+				}
+				writeExplanation(os.Stdout, pe)
+			}
+			return nil
+		}
+	},
+}
+
+// englishList joins ss into e.g. "a, b and c".
+func englishList(ss []string) string {
+	switch len(ss) {
+	case 0:
+		return ""
+	case 1:
+		return ss[0]
+	}
+	return strings.Join(ss[:len(ss)-1], ", ") + " and " + ss[len(ss)-1]
+}
+
+func plural(n int, one, many string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, one)
+	}
+	return fmt.Sprintf("%d %s", n, many)
+}
+
+func names(its []overview.Item) []string {
+	out := make([]string, len(its))
+	for i, it := range its {
+		out[i] = strings.TrimSuffix(it.Name, ".")
+	}
+	return out
+}
+
+func tabNames(trs []overview.TabRef) string {
+	out := make([]string, len(trs))
+	for i, tr := range trs {
+		out[i] = fmt.Sprintf("%d (%s)", tr.Id, tr.Name)
+	}
+	return englishList(out)
+}
+
+func writeExplanation(w io.Writer, pe *overview.PresetExplanation) {
+	fmt.Fprintf(w, "The %+q preset shows %s", pe.Name, plural(pe.Groups, "group", "groups"))
+	if len(pe.Categories) == 0 {
+		fmt.Fprintf(w, ".\n")
+	} else {
+		fmt.Fprintf(w, ", from %s:\n", plural(len(pe.Categories), "category", "categories"))
+	}
+	for _, cc := range pe.Categories {
+		switch {
+		case cc.Full() && cc.Total == 1:
+			fmt.Fprintf(w, "  - the only %s group, %s.\n", cc.Name, cc.Included[0].Name)
+		case cc.Full():
+			fmt.Fprintf(w, "  - all %d %s groups.\n", cc.Total, cc.Name)
+		case len(cc.Excluded) < len(cc.Included):
+			fmt.Fprintf(w, "  - all of the %s groups except %s (%d of %d).\n", cc.Name,
+				englishList(names(cc.Excluded)), len(cc.Included), cc.Total)
+		default:
+			fmt.Fprintf(w, "  - %d of the %d %s groups: %s.\n", len(cc.Included), cc.Total, cc.Name,
+				englishList(names(cc.Included)))
+		}
+	}
+	if len(pe.Unlisted) > 0 {
+		var ss []string
+		for _, it := range pe.Unlisted {
+			ss = append(ss, fmt.Sprintf("%s (%v)", it.Name, it.ID))
+		}
+		fmt.Fprintf(w, "It also shows %s that the \"All\" preset doesn't: %s.\n",
+			plural(len(pe.Unlisted), "group", "groups"), englishList(ss))
+	}
+	if len(pe.Filtered) == 0 {
+		fmt.Fprintf(w, "It doesn't hide any pilots based on their standing or state.\n")
+	} else {
+		fmt.Fprintf(w, "It hides pilots when:\n")
+		for _, name := range names(pe.Filtered) {
+			fmt.Fprintf(w, "  - %s\n", name)
+		}
+	}
+	if len(pe.AlwaysShown) > 0 {
+		fmt.Fprintf(w, "It always shows pilots when")
+		if len(pe.Filtered) > 0 {
+			fmt.Fprintf(w, " (even if they would otherwise be hidden)")
+		}
+		fmt.Fprintf(w, ":\n")
+		for _, name := range names(pe.AlwaysShown) {
+			fmt.Fprintf(w, "  - %s\n", name)
+		}
+	}
+	switch {
+	case len(pe.OverviewFor) > 0 && len(pe.BracketFor) > 0:
+		fmt.Fprintf(w, "It's the overview preset of tab %s, and the bracket preset of tab %s.\n",
+			tabNames(pe.OverviewFor), tabNames(pe.BracketFor))
+	case len(pe.OverviewFor) > 0:
+		fmt.Fprintf(w, "It's the overview preset of tab %s.\n", tabNames(pe.OverviewFor))
+	case len(pe.BracketFor) > 0:
+		fmt.Fprintf(w, "It's the bracket preset of tab %s.\n", tabNames(pe.BracketFor))
+	default:
+		fmt.Fprintf(w, "It isn't used by any tab.\n")
+	}
+}
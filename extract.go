@@ -0,0 +1,70 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"flag"
+	"io/ioutil"
+	"os"
+
+	"github.com/kormat/eve-overview-tool/overview"
+)
+
+var extractCmd = &command{
+	name:  "extract",
+	args:  "FILE NAME...",
+	short: "Write a standalone overview with just the named presets and tabs.",
+	setup: func(fs *flag.FlagSet) func([]string) error {
+		var cf catalogFlags
+		var pf parseFlags
+		var of outputFlags
+		cf.register(fs)
+		pf.register(fs)
+		of.register(fs)
+		out := fs.String("o", "", "Write the output to this file instead of stdout")
+		appearance := fs.Bool("appearance", false,
+			"Also copy the state colours and blinks, flag and background states, and ship labels")
+		return func(args []string) error {
+			if len(args) < 2 {
+				return usagef("expected an overview file and preset or tab names")
+			}
+			opts, err := of.options(true)
+			if err != nil {
+				return err
+			}
+			cat, err := cf.load()
+			if err != nil {
+				return err
+			}
+			o, err := pf.load(args[0])
+			if err != nil {
+				return err
+			}
+			eo, err := overview.Extract(o, args[1:], *appearance)
+			if err != nil {
+				return err
+			}
+			b, err := overview.MarshalWithOptions(eo, cat, opts)
+			if err != nil {
+				return err
+			}
+			if *out != "" {
+				return ioutil.WriteFile(*out, b, 0644)
+			}
+			_, err = os.Stdout.Write(b)
+			return err
+		}
+	},
+}
@@ -0,0 +1,219 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/kormat/eve-overview-tool/overview"
+)
+
+// catalogFlags are the flags for loading external data files.
+type catalogFlags struct {
+	files overview.CatalogFiles
+}
+
+func (cf *catalogFlags) register(fs *flag.FlagSet) {
+	fs.StringVar(&cf.files.Categories, "categories", "",
+		"Use external inventory categories CSV file.")
+	fs.StringVar(&cf.files.Groups, "groups", "", "Use external inventory groups CSV file.")
+	fs.StringVar(&cf.files.States, "states", "", "Use external filter states CSV file")
+	fs.StringVar(&cf.files.Columns, "columns", "", "Use external overview columns CSV file")
+	fs.StringVar(&cf.files.Replacements, "replacements", "",
+		"Use external group replacements CSV file")
+	fs.StringVar(&cf.files.Types, "types", "",
+		"Use inventory types CSV file, e.g. invTypes.csv.bz2 from the SDE")
+}
+
+func (cf *catalogFlags) load() (*overview.Catalog, error) {
+	cat, err := overview.LoadCatalog(cf.files)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load data files: %s", err)
+	}
+	return cat, nil
+}
+
+// parseFlags are the flags controlling how overview files are parsed.
+type parseFlags struct {
+	strict bool
+}
+
+func (pf *parseFlags) register(fs *flag.FlagSet) {
+	fs.BoolVar(&pf.strict, "strict", false,
+		"Treat unknown sections and preset/tab attributes as errors, instead of passing them through.")
+}
+
+// parse parses the named overview file, and returns it along with every
+// problem found.
+func (pf *parseFlags) parse(name string) (*overview.Overview, overview.Diagnostics, error) {
+	b, err := ioutil.ReadFile(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	o, diags := overview.ParseWithOptions(b, overview.ParseOptions{
+		Filename: name, KeepGoing: true, Strict: pf.strict,
+	})
+	return o, diags, nil
+}
+
+// load parses the named overview file, logging every problem found. An error
+// is returned if any of the problems are errors.
+func (pf *parseFlags) load(name string) (*overview.Overview, error) {
+	o, diags, err := pf.parse(name)
+	if err != nil {
+		return nil, err
+	}
+	logDiags(diags)
+	if diags.HasErrors() {
+		return nil, fmt.Errorf("%s has errors", name)
+	}
+	return o, nil
+}
+
+func logDiags(diags overview.Diagnostics) {
+	for _, diag := range diags {
+		log.Printf("%s: %s", strings.ToUpper(diag.Severity.String()), diag)
+	}
+}
+
+// outputFlags are the flags controlling how output is formatted.
+type outputFlags struct {
+	eol           string
+	indent        int
+	bom           bool
+	groupComment  string
+	stateComment  string
+	columnComment string
+}
+
+func (of *outputFlags) register(fs *flag.FlagSet) {
+	fs.StringVar(&of.eol, "eol", "",
+		"Line endings to write, 'crlf' or 'lf'. Defaults to crlf for overviews, and lf for groups/.")
+	fs.IntVar(&of.indent, "indent", 2, "Number of spaces per indentation level")
+	fs.BoolVar(&of.bom, "bom", false, "Start the output with a UTF-8 byte order mark")
+	fs.StringVar(&of.groupComment, "group-comment", overview.DefaultGroupComment,
+		"Template for group annotations. Fields: ID, Name, Category, CategoryID")
+	fs.StringVar(&of.stateComment, "state-comment", overview.DefaultStateComment,
+		"Template for filter state annotations. Fields: ID, Name")
+	fs.StringVar(&of.columnComment, "column-comment", overview.DefaultColumnComment,
+		"Template for column annotations. Fields: ID, Name")
+}
+
+// options returns the output options set by the flags. crlf sets the default
+// line endings, if not set by the -eol flag.
+func (of *outputFlags) options(crlf bool) (overview.OutputOptions, error) {
+	switch strings.ToLower(of.eol) {
+	case "crlf":
+		crlf = true
+	case "lf":
+		crlf = false
+	case "":
+	default:
+		return overview.OutputOptions{}, usagef("unknown line ending %+q", of.eol)
+	}
+	return overview.OutputOptions{
+		CRLF:          crlf,
+		Indent:        of.indent,
+		BOM:           of.bom,
+		GroupComment:  of.groupComment,
+		StateComment:  of.stateComment,
+		ColumnComment: of.columnComment,
+	}, nil
+}
+
+// writeFlags are the flags for where a command that edits an overview file
+// writes the result.
+type writeFlags struct {
+	out     string
+	inPlace bool
+}
+
+func (wf *writeFlags) register(fs *flag.FlagSet) {
+	fs.StringVar(&wf.out, "o", "", "Write the output to this file instead of stdout")
+	fs.BoolVar(&wf.inPlace, "w", false, "Write the output back to the input file")
+}
+
+// check returns a usage error if the flags conflict.
+func (wf *writeFlags) check() error {
+	if wf.inPlace && wf.out != "" {
+		return usagef("-o and -w can't be used together")
+	}
+	return nil
+}
+
+// toStdout returns whether the output is written to stdout.
+func (wf *writeFlags) toStdout() bool {
+	return wf.out == "" && !wf.inPlace
+}
+
+// write writes b, the edited version of the named file.
+func (wf *writeFlags) write(name string, b []byte) error {
+	out := wf.out
+	if wf.inPlace {
+		out = name
+	}
+	if wf.toStdout() {
+		_, err := os.Stdout.Write(b)
+		return err
+	}
+	return ioutil.WriteFile(out, b, 0644)
+}
+
+// oneFile returns the single file argument of a command.
+func oneFile(args []string) (string, error) {
+	if len(args) != 1 {
+		return "", usagef("expected 1 overview file, got %d arguments", len(args))
+	}
+	return args[0], nil
+}
+
+// allGroupsFlags are the flags for loading the list of groups that can be
+// shown on the overview.
+type allGroupsFlags struct {
+	dir string
+	set bool
+}
+
+func (af *allGroupsFlags) register(fs *flag.FlagSet) {
+	fs.Var(af, "groups-dir",
+		"Directory of per-category group lists from update-groups (default \"groups\", "+
+			"falling back to the groups published in the SDE)")
+}
+
+func (af *allGroupsFlags) String() string {
+	return af.dir
+}
+
+func (af *allGroupsFlags) Set(s string) error {
+	af.dir, af.set = s, true
+	return nil
+}
+
+func (af *allGroupsFlags) load(cat *overview.Catalog) (overview.AllGroups, error) {
+	if af.set {
+		return overview.LoadAllGroups(af.dir, cat)
+	}
+	ag, err := overview.LoadAllGroups("groups", cat)
+	if err != nil {
+		log.Printf("NOTE: %s, using the groups published in the SDE instead", err)
+		return overview.PublishedGroups(cat), nil
+	}
+	return ag, nil
+}
@@ -0,0 +1,102 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/kormat/eve-overview-tool/overview"
+)
+
+var fmtCmd = &command{
+	name:  "fmt",
+	args:  "FILE...",
+	short: "Rewrite files in a canonical form, or check that they are.",
+	setup: func(fs *flag.FlagSet) func([]string) error {
+		var cf catalogFlags
+		var pf parseFlags
+		var of outputFlags
+		var wf writeFlags
+		cf.register(fs)
+		pf.register(fs)
+		of.register(fs)
+		wf.register(fs)
+		check := fs.Bool("check", false,
+			"List the files that aren't in canonical form, and exit with 1 if there are any")
+		return func(args []string) error {
+			if len(args) == 0 {
+				return usagef("expected at least 1 overview file")
+			}
+			if err := wf.check(); err != nil {
+				return err
+			}
+			if len(args) > 1 && !*check && !wf.inPlace {
+				return usagef("more than 1 file can only be formatted with -w or -check")
+			}
+			opts, err := of.options(true)
+			if err != nil {
+				return err
+			}
+			cat, err := cf.load()
+			if err != nil {
+				return err
+			}
+			unformatted := 0
+			for _, name := range args {
+				orig, err := ioutil.ReadFile(name)
+				if err != nil {
+					return err
+				}
+				o, err := pf.load(name)
+				if err != nil {
+					return err
+				}
+				o.Canonicalise()
+				b, err := overview.MarshalWithOptions(o, cat, opts)
+				if err != nil {
+					return fmt.Errorf("%s: %s", name, err)
+				}
+				formatted, err := overview.Parse(b)
+				if err != nil {
+					return fmt.Errorf("%s: %s", name, err)
+				}
+				lost, err := overview.LostComments(o, formatted, cat, opts)
+				if err != nil {
+					return fmt.Errorf("%s: %s", name, err)
+				}
+				if len(lost) > 0 {
+					return fmt.Errorf("%s: formatting would lose comments %+q", name, lost)
+				}
+				if *check {
+					if !bytes.Equal(orig, b) {
+						fmt.Println(name)
+						unformatted++
+					}
+					continue
+				}
+				if err := wf.write(name, b); err != nil {
+					return err
+				}
+			}
+			if unformatted > 0 {
+				return errSilent
+			}
+			return nil
+		}
+	},
+}
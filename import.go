@@ -0,0 +1,90 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/kormat/eve-overview-tool/overview"
+)
+
+var importCmd = &command{
+	name:  "import",
+	args:  "FILE FROM [NAME...]",
+	short: "Copy presets, and optionally their tabs, from another overview file.",
+	setup: func(fs *flag.FlagSet) func([]string) error {
+		var cf catalogFlags
+		var pf parseFlags
+		var of outputFlags
+		var wf writeFlags
+		cf.register(fs)
+		pf.register(fs)
+		of.register(fs)
+		wf.register(fs)
+		strategy := fs.String("strategy", "skip",
+			"What to do with presets that already exist: skip, overwrite, suffix or merge-groups")
+		tabs := fs.Bool("tabs", false, "Also copy the tabs that use the imported presets")
+		return func(args []string) error {
+			if len(args) < 2 {
+				return usagef("expected an overview file and a file to import from")
+			}
+			if err := wf.check(); err != nil {
+				return err
+			}
+			is, err := overview.ParseImportStrategy(*strategy)
+			if err != nil {
+				return usagef("%s", err)
+			}
+			opts, err := of.options(true)
+			if err != nil {
+				return err
+			}
+			cat, err := cf.load()
+			if err != nil {
+				return err
+			}
+			o, err := pf.load(args[0])
+			if err != nil {
+				return err
+			}
+			src, err := pf.load(args[1])
+			if err != nil {
+				return err
+			}
+			changes, err := o.Import(src, args[2:], *tabs, is)
+			if err != nil {
+				return err
+			}
+			b, err := overview.MarshalWithOptions(o, cat, opts)
+			if err != nil {
+				return err
+			}
+			if err := wf.write(args[0], b); err != nil {
+				return err
+			}
+			// Keep the summary out of the overview, if that's on stdout.
+			w := os.Stdout
+			if wf.toStdout() {
+				w = os.Stderr
+			}
+			for _, ic := range changes {
+				fmt.Fprintln(w, ic)
+			}
+			return nil
+		}
+	},
+}
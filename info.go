@@ -0,0 +1,113 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/kormat/eve-overview-tool/overview"
+)
+
+var infoCmd = &command{
+	name:    "info",
+	aliases: []string{"stats"},
+	args:    "FILE",
+	short:   "Print a summary of an overview file.",
+	setup: func(fs *flag.FlagSet) func([]string) error {
+		var cf catalogFlags
+		var pf parseFlags
+		cf.register(fs)
+		pf.register(fs)
+		jsonOut := fs.Bool("json", false, "Write the summary as JSON")
+		return func(args []string) error {
+			name, err := oneFile(args)
+			if err != nil {
+				return err
+			}
+			cat, err := cf.load()
+			if err != nil {
+				return err
+			}
+			o, err := pf.load(name)
+			if err != nil {
+				return err
+			}
+			s := overview.Summarise(o, cat)
+			if *jsonOut {
+				enc := json.NewEncoder(os.Stdout)
+				enc.SetIndent("", "  ")
+				return enc.Encode(s)
+			}
+			return writeSummary(os.Stdout, s)
+		}
+	},
+}
+
+func writeSummary(w io.Writer, s *overview.Summary) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(tw, "Presets:\t%d\n", s.Presets)
+	fmt.Fprintf(tw, "Tabs:\t%d\n", s.Tabs)
+	fmt.Fprintf(tw, "Background states:\t%d\n", s.BackgroundStates)
+	fmt.Fprintf(tw, "Flag states:\t%d\n", s.FlagStates)
+	fmt.Fprintf(tw, "Unused presets:\t%s\n", orNone(s.UnusedPresets))
+	var cols []string
+	for _, col := range s.Columns {
+		cols = append(cols, col.Name)
+	}
+	fmt.Fprintf(tw, "Enabled columns:\t%s\n", orNone(cols))
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	if len(s.PresetStats) > 0 {
+		fmt.Fprintf(w, "\n")
+		tw = tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+		fmt.Fprintf(tw, "PRESET\tGROUPS\tSHOWN\tFILTERED\tTABS\tCATEGORIES\n")
+		for _, ps := range s.PresetStats {
+			var tabs, cats []string
+			for _, id := range ps.Tabs {
+				tabs = append(tabs, fmt.Sprint(id))
+			}
+			for _, cc := range ps.Categories {
+				cats = append(cats, fmt.Sprintf("%s (%d): %d", cc.Name, int(cc.ID), cc.Groups))
+			}
+			if len(tabs) == 0 {
+				tabs = []string{"-"}
+			}
+			fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%s\t%s\n", ps.Name, ps.Groups, ps.AlwaysShownStates,
+				ps.FilteredStates, strings.Join(tabs, ","), strings.Join(cats, ", "))
+		}
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+	}
+
+	if len(s.ShipLabels) > 0 {
+		fmt.Fprintf(w, "\n")
+		tw = tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+		fmt.Fprintf(tw, "LABEL\tTYPE\tPRE\tPOST\tENABLED\n")
+		for _, ls := range s.ShipLabels {
+			fmt.Fprintf(tw, "%s\t%s\t%+q\t%+q\t%t\n", ls.Name, ls.Type, ls.Pre, ls.Post, ls.Enabled)
+		}
+		return tw.Flush()
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"text/tabwriter"
+
+	"github.com/kormat/eve-overview-tool/overview"
+)
+
+// defaultLintConfig is the lint config file used if -config isn't set, if it
+// exists.
+const defaultLintConfig = ".eot-lint.yaml"
+
+var lintCmd = &command{
+	name:  "lint",
+	args:  "FILE...",
+	short: "Check overview files with the lint rules.",
+	setup: func(fs *flag.FlagSet) func([]string) error {
+		var cf catalogFlags
+		var pf parseFlags
+		cf.register(fs)
+		pf.register(fs)
+		config := fs.String("config", "",
+			"Lint config file to use (default "+defaultLintConfig+", if it exists)")
+		list := fs.Bool("rules", false, "List the lint rules, and exit")
+		werror := fs.Bool("werror", false, "Treat warnings as errors")
+		return func(args []string) error {
+			if *list {
+				w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+				for _, r := range overview.Rules() {
+					fmt.Fprintf(w, "%s\t%s\t%s\n", r.ID, r.Severity, r.Desc)
+				}
+				return w.Flush()
+			}
+			if len(args) == 0 {
+				return usagef("no overview files given")
+			}
+			cfg, err := loadLintConfig(*config)
+			if err != nil {
+				return err
+			}
+			cat, err := cf.load()
+			if err != nil {
+				return err
+			}
+			return checkFiles(args, &pf, *werror, func(o *overview.Overview) overview.Diagnostics {
+				return overview.Lint(o, cat, cfg)
+			})
+		}
+	},
+}
+
+func loadLintConfig(name string) (*overview.LintConfig, error) {
+	if name == "" {
+		if _, err := os.Stat(defaultLintConfig); err != nil {
+			return nil, nil
+		}
+		name = defaultLintConfig
+	}
+	b, err := ioutil.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := overview.ParseLintConfig(b)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", name, err)
+	}
+	return cfg, nil
+}
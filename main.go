@@ -15,133 +15,228 @@
 package main
 
 import (
-	"bufio"
-	"bytes"
+	"errors"
 	"flag"
 	"fmt"
+	"io"
 	"log"
 	"os"
-	"path"
-	"regexp"
 	"strings"
+)
+
+// version is set at build time with -ldflags "-X main.version=...".
+var version = "dev"
 
-	"gopkg.in/yaml.v2"
+// Exit codes.
+const (
+	exitOK      = 0
+	exitFailure = 1
+	exitUsage   = 2
 )
 
-const commentMarker = "EOTCOMMENT"
-const allGroupPreset = "all"
+// command is a subcommand of the tool.
+type command struct {
+	name string
+	// aliases are other names the command can be run as.
+	aliases []string
+	// args describes the positional arguments, for the usage message.
+	args  string
+	short string
+	// setup registers the command's flags, and returns the function that
+	// runs it with the remaining arguments.
+	setup func(fs *flag.FlagSet) func(args []string) error
+}
 
-var cfgFile = flag.String("f", "", "Overview file to operate on")
-var updGroups = flag.Bool("update-groups", false, "Update groups/ using an 'All' preset.")
+// names returns the name and aliases of the command.
+func (cmd *command) names() []string {
+	return append([]string{cmd.name}, cmd.aliases...)
+}
 
-var invGroups map[InvGroupId]*InvGroup
-var invCategories map[InvCategoryId]string
-var stateTypes map[StateType]string
+// usageError is returned by a command when it was invoked incorrectly.
+type usageError struct {
+	msg string
+}
 
-func main() {
-	var err error
-	flag.Parse()
-	if *cfgFile == "" {
-		log.Printf("ERROR: No overview file specified.")
-		os.Exit(1)
+func (ue *usageError) Error() string {
+	return ue.msg
+}
+
+func usagef(format string, a ...interface{}) error {
+	return &usageError{msg: fmt.Sprintf(format, a...)}
+}
+
+// errSilent makes a command exit with exitFailure without logging an error,
+// as the result has already been reported.
+var errSilent = errors.New("silent failure")
+
+var commands []*command
+
+func init() {
+	commands = []*command{
+		annotateCmd,
+		updateGroupsCmd,
+		validateCmd,
+		lintCmd,
+		fmtCmd,
+		unknownGroupsCmd,
+		driftCmd,
+		diffCmd,
+		mergeCmd,
+		composeCmd,
+		compileCmd,
+		renamePresetCmd,
+		extractCmd,
+		importCmd,
+		queryCmd,
+		simulateCmd,
+		explainCmd,
+		infoCmd,
+		versionCmd,
+		completionCmd,
+		helpCmd,
 	}
-	if invCategories, err = loadCategories(); err != nil {
-		log.Printf("ERROR: unable to load inventory categories CSV file: %s", err)
-		os.Exit(1)
+}
+
+func main() {
+	log.SetFlags(0)
+	os.Exit(run(os.Args[1:]))
+}
+
+func run(args []string) int {
+	if len(args) == 0 {
+		usage(os.Stderr)
+		return exitUsage
 	}
-	if invGroups, err = loadGroups(); err != nil {
-		log.Printf("ERROR: unable to load inventory types CSV file: %s", err)
-		os.Exit(1)
+	name := args[0]
+	if strings.HasPrefix(name, "-") && !isHelpFlag(name) {
+		name, args = legacyArgs(args)
 	}
-	if stateTypes, err = loadStates(); err != nil {
-		log.Printf("ERROR: unable to load filter state types CSV file: %s", err)
-		os.Exit(1)
+	if isHelpFlag(name) {
+		usage(os.Stdout)
+		return exitOK
 	}
-	var o *Overview
-	if o, err = loadConfig(); err != nil {
-		log.Printf("ERROR: unable to load overview file: %s", err)
-		os.Exit(1)
+	cmd := findCommand(name)
+	if cmd == nil {
+		log.Printf("ERROR: unknown command %+q", name)
+		usage(os.Stderr)
+		return exitUsage
 	}
-	if *updGroups {
-		if err = updateGroups(o); err != nil {
-			log.Printf("ERROR: unable to update groups/: %s", err)
-			os.Exit(1)
+	fs := flag.NewFlagSet(cmd.name, flag.ContinueOnError)
+	fs.Usage = func() { commandUsage(fs.Output(), cmd, fs) }
+	runFn := cmd.setup(fs)
+	if err := fs.Parse(args[1:]); err != nil {
+		if err == flag.ErrHelp {
+			return exitOK
 		}
-		return
-	}
-	b, err := yaml.Marshal(o)
-	if err != nil {
-		log.Printf("ERROR: unable to marshal back to yaml: %s", err)
-		os.Exit(1)
+		return exitUsage
 	}
-	fmt.Printf("%s", string(unescapeComments(b)))
-}
-
-var quotesRx = regexp.MustCompile(`^(?P<start>^\s*(- )+)'?(?P<entry>\d+ ` + commentMarker + ` .+)$`)
-
-func unescapeComments(b []byte) []byte {
-	var out bytes.Buffer
-	s := bufio.NewScanner(bytes.NewReader(b))
-	for s.Scan() {
-		line := s.Bytes()
-		matches := quotesRx.FindStringSubmatch(string(line))
-		if len(matches) > 0 {
-			out.WriteString(matches[1])
-			out.WriteString(strings.Replace(matches[3], commentMarker, "#", 1))
-		} else {
-			out.Write(line)
+	if err := runFn(fs.Args()); err != nil {
+		if err == errSilent {
+			return exitFailure
 		}
-		out.WriteString("\r\n")
+		if _, ok := err.(*usageError); ok {
+			log.Printf("ERROR: %s", err)
+			fs.SetOutput(os.Stderr)
+			fs.Usage()
+			return exitUsage
+		}
+		log.Printf("ERROR: %s", err)
+		return exitFailure
 	}
-	return out.Bytes()
+	return exitOK
 }
 
-func updateGroups(o *Overview) error {
-	var p *Preset
-	for i := range o.Presets {
-		if strings.ToLower(o.Presets[i].Name) == allGroupPreset {
-			p = o.Presets[i]
-			break
+// legacyArgs converts an old-style invocation (e.g. "-f orig.yaml"), which
+// annotates, or updates the group lists if -update-groups is given, into the
+// equivalent command.
+func legacyArgs(args []string) (string, []string) {
+	name := annotateCmd.name
+	var rest []string
+	for _, arg := range args {
+		switch arg {
+		case "-update-groups", "--update-groups", "-update-groups=true", "--update-groups=true":
+			name = updateGroupsCmd.name
+		case "-update-groups=false", "--update-groups=false":
+		default:
+			rest = append(rest, arg)
 		}
 	}
-	if p == nil {
-		return fmt.Errorf("No 'All' preset found")
-	}
-	// Make a list of inventory group IDs per category.
-	cats := make(map[InvCategoryId][]InvGroupId)
-	for _, invG := range p.Groups.Groups {
-		cat := invGroups[invG].Cat
-		cats[cat] = append(cats[cat], invG)
-	}
-	for cat, invgs := range cats {
-		if err := updateGroup(catToFilename(cat), invgs); err != nil {
-			return err
+	return name, append([]string{name}, rest...)
+}
+
+func isHelpFlag(s string) bool {
+	return s == "-h" || s == "-help" || s == "--help"
+}
+
+func findCommand(name string) *command {
+	for _, cmd := range commands {
+		if cmd.name == name || isKnown(name, cmd.aliases) {
+			return cmd
 		}
 	}
 	return nil
 }
 
-func updateGroup(catFile string, invgids []InvGroupId) error {
-	f, err := os.OpenFile(path.Join("groups", catFile), os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
-	if err != nil {
-		return err
-	}
-	for _, invgid := range invgids {
-		invg := invGroups[invgid]
-		// Do manual marshalling here, as it's just easier. Indent by 8 spaces
-		// to allow easy use in creating an overview.
-		l := fmt.Sprintf("        - %d # %s -- %s\n", invg.Id, invg.Cat, invg.Name)
-		if _, err = f.WriteString(l); err != nil {
-			return err
+func isKnown(name string, names []string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
 		}
 	}
-	if err := f.Close(); err != nil {
-		return err
+	return false
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintf(w, "Usage: eve-overview-tool <command> [flags] [args]\n\nCommands:\n")
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "  %-15s %s\n", strings.Join(cmd.names(), ", "),
+			cmd.short)
+	}
+	fmt.Fprintf(w, "\nRun 'eve-overview-tool help <command>' for details of a command.\n")
+	fmt.Fprintf(w, "\nExit codes: %d success, %d failure or errors found, %d usage error.\n",
+		exitOK, exitFailure, exitUsage)
+}
+
+func commandUsage(w io.Writer, cmd *command, fs *flag.FlagSet) {
+	fmt.Fprintf(w, "Usage: eve-overview-tool %s [flags] %s\n\n%s\n", cmd.name, cmd.args, cmd.short)
+	hasFlags := false
+	fs.VisitAll(func(*flag.Flag) { hasFlags = true })
+	if hasFlags {
+		fmt.Fprintf(w, "\nFlags:\n")
+		fs.SetOutput(w)
+		fs.PrintDefaults()
 	}
-	return nil
 }
 
-func catToFilename(cat InvCategoryId) string {
-	n := strings.ToLower(invCategories[cat])
-	return strings.Replace(n, " ", "_", -1) + ".yaml"
+var versionCmd = &command{
+	name:  "version",
+	short: "Print the version of the tool.",
+	setup: func(fs *flag.FlagSet) func([]string) error {
+		return func(args []string) error {
+			fmt.Printf("eve-overview-tool %s\n", version)
+			return nil
+		}
+	},
+}
+
+var helpCmd = &command{
+	name:  "help",
+	args:  "[command]",
+	short: "Show help for the tool, or for a command.",
+	setup: func(fs *flag.FlagSet) func([]string) error {
+		return func(args []string) error {
+			if len(args) == 0 {
+				usage(os.Stdout)
+				return nil
+			}
+			cmd := findCommand(args[0])
+			if cmd == nil {
+				return usagef("unknown command %+q", args[0])
+			}
+			cfs := flag.NewFlagSet(cmd.name, flag.ContinueOnError)
+			cmd.setup(cfs)
+			commandUsage(os.Stdout, cmd, cfs)
+			return nil
+		}
+	},
 }
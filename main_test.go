@@ -0,0 +1,126 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const mergeBase = `presets:
+- - pvp
+  - - - groups
+      - - 25
+tabSetup:
+- - 0
+  - - - name
+      - PvP
+    - - overview
+      - pvp
+`
+
+func writeFile(t *testing.T, dir, name, s string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(s), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestMergeExitCodes(t *testing.T) {
+	tests := []struct {
+		name         string
+		ours, theirs string
+		extraArgs    []string
+		want         int
+	}{
+		{
+			name:   "clean",
+			ours:   strings.Replace(mergeBase, "PvP", "PvP!", 1),
+			theirs: strings.Replace(mergeBase, "- 25", "- 26", 1),
+			want:   exitOK,
+		},
+		{
+			name:   "conflict",
+			ours:   strings.Replace(mergeBase, "      - pvp\n", "      - a\n", 1),
+			theirs: strings.Replace(mergeBase, "      - pvp\n", "      - b\n", 1),
+			want:   exitFailure,
+		},
+		{
+			name:      "wrong arguments",
+			ours:      mergeBase,
+			theirs:    mergeBase,
+			extraArgs: []string{"extra.yaml"},
+			want:      exitUsage,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir := t.TempDir()
+			args := []string{"merge", "-o", filepath.Join(dir, "out.yaml"),
+				writeFile(t, dir, "base.yaml", mergeBase),
+				writeFile(t, dir, "ours.yaml", test.ours),
+				writeFile(t, dir, "theirs.yaml", test.theirs)}
+			if got := run(append(args, test.extraArgs...)); got != test.want {
+				t.Errorf("exit code = %d, want %d", got, test.want)
+			}
+		})
+	}
+}
+
+func TestLegacyArgs(t *testing.T) {
+	tests := []struct {
+		args []string
+		want []string
+	}{
+		{[]string{"-f", "orig.yaml"}, []string{"annotate", "-f", "orig.yaml"}},
+		{[]string{"-f", "orig.yaml", "-update-groups"}, []string{"update-groups", "-f", "orig.yaml"}},
+		{[]string{"--update-groups=true", "-f=orig.yaml"}, []string{"update-groups", "-f=orig.yaml"}},
+		{[]string{"-update-groups=false", "-f", "orig.yaml"}, []string{"annotate", "-f", "orig.yaml"}},
+	}
+	for _, test := range tests {
+		name, args := legacyArgs(test.args)
+		if name != test.want[0] || !reflect.DeepEqual(args, test.want) {
+			t.Errorf("legacyArgs(%q) = %q, %q, want %q", test.args, name, args, test.want)
+		}
+	}
+}
+
+func TestAnnotateExitCodes(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want int
+	}{
+		{"valid", mergeBase, exitOK},
+		{"tab uses a missing preset", strings.Replace(mergeBase, "      - pvp\n", "      - nope\n", 1), exitFailure},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir := t.TempDir()
+			out := filepath.Join(dir, "out.yaml")
+			if got := run([]string{"annotate", "-o", out, writeFile(t, dir, "in.yaml", test.in)}); got != test.want {
+				t.Errorf("exit code = %d, want %d", got, test.want)
+			}
+			if _, err := os.Stat(out); err != nil {
+				t.Errorf("annotated file not written: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/kormat/eve-overview-tool/overview"
+)
+
+var mergeCmd = &command{
+	name:  "merge",
+	args:  "BASE OURS THEIRS",
+	short: "Merge the changes made to BASE in OURS and THEIRS.",
+	setup: func(fs *flag.FlagSet) func([]string) error {
+		var cf catalogFlags
+		var pf parseFlags
+		var of outputFlags
+		cf.register(fs)
+		pf.register(fs)
+		of.register(fs)
+		out := fs.String("o", "", "Write the merged file to this file instead of stdout")
+		markers := fs.Bool("markers", false, "Mark conflicting elements with a comment in the merged file")
+		return func(args []string) error {
+			if len(args) != 3 {
+				return usagef("expected 3 overview files, got %d arguments", len(args))
+			}
+			opts, err := of.options(true)
+			if err != nil {
+				return err
+			}
+			cat, err := cf.load()
+			if err != nil {
+				return err
+			}
+			var os3 [3]*overview.Overview
+			for i, name := range args {
+				if os3[i], err = pf.load(name); err != nil {
+					return err
+				}
+			}
+			o, conflicts := overview.Merge(os3[0], os3[1], os3[2])
+			if *markers {
+				o.MarkConflicts(conflicts)
+			}
+			b, err := overview.MarshalWithOptions(o, cat, opts)
+			if err != nil {
+				return err
+			}
+			if *out != "" {
+				err = ioutil.WriteFile(*out, b, 0644)
+			} else {
+				_, err = os.Stdout.Write(b)
+			}
+			if err != nil {
+				return err
+			}
+			for _, c := range conflicts {
+				fmt.Fprintf(os.Stderr, "CONFLICT: %s\n", c)
+			}
+			if len(conflicts) > 0 {
+				return fmt.Errorf("%d conflict(s), our version was kept for each", len(conflicts))
+			}
+			return nil
+		}
+	},
+}
@@ -1,11 +1,10 @@
-// Code generated by go-bindata.
+// Code generated for package overview by go-bindata DO NOT EDIT. (@generated)
 // sources:
+// data/columns.csv
 // data/filterStates.csv
 // data/invCategories.csv.bz2
 // data/invGroups.csv.bz2
-// DO NOT EDIT!
-
-package main
+package overview
 
 import (
 	"bytes"
@@ -51,26 +50,57 @@ type bindataFileInfo struct {
 	modTime time.Time
 }
 
+// Name return file name
 func (fi bindataFileInfo) Name() string {
 	return fi.name
 }
+
+// Size return file size
 func (fi bindataFileInfo) Size() int64 {
 	return fi.size
 }
+
+// Mode return file mode
 func (fi bindataFileInfo) Mode() os.FileMode {
 	return fi.mode
 }
+
+// Mode return file modify time
 func (fi bindataFileInfo) ModTime() time.Time {
 	return fi.modTime
 }
+
+// IsDir return file whether a directory
 func (fi bindataFileInfo) IsDir() bool {
-	return false
+	return fi.mode&os.ModeDir != 0
 }
+
+// Sys return file is sys mode
 func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _dataFilterstatesCsv = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x92\xc1\x8e\xd3\x40\x0c\x86\xef\x7e\x0a\x1f\x41\x2a\xd0\xb4\xc9\x2e\x7b\x45\x20\xc4\xa5\x42\x02\x89\xb3\x3b\xf1\x26\xd6\x3a\xe3\x6a\xc6\xd9\x90\xb7\x47\xa1\x5d\x75\x4a\x24\xb4\xa7\x48\xf3\x7d\x76\xe6\xff\x93\xec\xe4\xfc\xed\xf3\xe6\xef\xf3\x40\x03\xc3\xc3\xe6\xbb\xa8\x39\xf6\x94\x91\x30\x73\x18\x93\xf8\x8c\x8b\x30\x66\x3c\xb2\xda\x84\xef\x1a\xa8\xb6\xaf\xf0\xb6\x50\x55\x17\x4d\x32\x4a\xc4\xd9\xc6\x84\x8f\xca\xec\x50\xed\xd6\x24\x58\x3a\x59\x22\x17\x8b\x50\xed\xaf\x9c\x1c\x27\x4a\x38\x89\xf7\x2b\xf1\x03\xa9\x0a\xc5\xc0\x50\xd5\xeb\x8d\x57\xd8\x14\xf7\xfd\xf2\x3b\xb0\x2a\x47\xc7\x1f\x4e\xb1\x95\xd8\xbd\x87\xea\xae\x10\xbe\x9a\xb5\x25\xbb\x2f\xd8\x81\x47\x4f\xa4\x25\xfe\x58\xe0\x4f\x74\x33\x59\xd6\xf9\x93\x53\x92\xa3\x72\xc1\x77\x65\x8d\x47\x1b\xa3\xcf\x68\x11\x7b\x19\x60\xf7\x52\xdd\x1b\xea\x38\xfa\xdb\x73\x2c\xe7\x44\xc1\xe9\xa8\x0c\xfb\xbb\xcd\xaf\xc4\xe1\x09\x49\x13\x53\x3b\xe3\xb3\xf0\xc4\x2d\xec\xef\x2f\xe7\x92\x91\x87\x93\xcf\x50\xd7\xff\xe9\x72\x10\x15\x17\x82\xba\x59\xd7\x77\x61\x68\xe7\x26\xb9\x45\xb7\x7f\xa6\xca\xec\x07\x2b\xa2\xd5\x0f\xc5\x4b\xe3\x32\x3f\x2f\x7b\x2d\xf2\xb2\x6e\xb0\xc4\x68\x8f\xe7\x65\x13\xa5\x0c\xcd\xb6\xf0\x31\x8f\xf9\xc4\xc1\xa1\xa9\xca\xd3\x90\x64\x90\x48\x0a\xcd\xee\xe6\xf7\x53\x19\xc4\xb9\x45\x8e\x1d\x75\x3c\x2c\x5f\xf6\x25\x20\x34\xfb\x1b\xf5\x49\x54\x31\x49\xd7\xfb\xa5\x68\xf4\x9e\x7c\x31\x31\x2c\xd7\x0c\x2e\xcf\xe4\x0c\x7f\x02\x00\x00\xff\xff\xe6\xa9\x72\x33\x1a\x03\x00\x00")
+var _dataColumnsCsv = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\xcc\x51\x6a\xc3\x30\x0c\xc6\xf1\x77\x9f\xa2\x07\xd0\x25\x84\xe3\x16\x81\xe3\x14\x47\x0b\x74\x6f\xc2\x33\x45\xe0\xda\x25\x49\x07\xd9\xe9\x07\x29\x84\xbe\x09\x7d\x3f\xfe\xa9\x95\xd7\xa3\x52\x07\xef\x23\xc8\x23\x1b\xb2\x43\x00\x4a\xad\x9a\x8e\x46\xc6\x60\x1d\x74\xba\xac\x52\x53\x36\x01\x7b\x07\xbb\xe2\xdb\xd5\x01\x6f\xcf\x6c\x18\x2f\xc0\x72\x37\x76\x88\xd7\x21\x22\xd3\x10\xc0\xb6\xf9\xd9\x66\x59\xb5\x55\x83\xde\xd3\x5e\xc1\x52\x74\xaf\x9c\xd1\xee\xea\x2c\x69\x17\x3d\x79\x62\x42\xe8\xb5\xe8\xaa\x62\x46\xfa\x76\x30\xea\x5f\x36\x93\xf3\x83\x25\xbe\xc1\x94\x4b\x4b\xba\x6e\x26\x62\x47\xe8\x8f\x7f\x94\x1f\x95\x72\x3a\x66\x8e\x18\xc6\xc9\xc5\xf1\xc3\xf0\x2c\x75\xf9\xcd\xf3\xf2\x09\x31\x5c\xbe\x3c\xc6\x03\x61\xbd\xbf\x8a\xcc\xa7\x29\x97\x96\x74\xdd\xcc\xff\x00\x23\xc9\x3c\x5c\x1b\x01\x00\x00")
+
+func dataColumnsCsvBytes() ([]byte, error) {
+	return bindataRead(
+		_dataColumnsCsv,
+		"data/columns.csv",
+	)
+}
+
+func dataColumnsCsv() (*asset, error) {
+	bytes, err := dataColumnsCsvBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/columns.csv", size: 283, mode: os.FileMode(420), modTime: time.Unix(1792299307, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _dataFilterstatesCsv = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x92\x41\x8b\xd4\x40\x10\x85\xef\xf5\x2b\xea\xa8\x30\xea\x66\x26\xd9\xd5\xab\x28\xe2\x65\x10\x14\x3c\xd7\x74\x6a\x93\x62\xbb\xbb\x86\xee\xca\xc6\xfc\x7b\x69\x27\x61\x5a\x07\x64\x4f\x81\x7c\xaf\x5e\xf1\x5e\x57\x36\x32\xfe\xfa\x69\xf7\xe7\x7b\xa4\xc0\xf0\x61\xf7\x4d\xbc\x1a\x8e\x94\x91\x30\xb3\x9b\x92\xd8\x82\x45\x30\x65\x3c\xb1\xd7\x19\xdf\x74\xd0\xdc\xbd\x40\x77\x07\x4d\xb3\xca\x24\xa3\x44\x5c\x74\x4a\xf8\xe8\x99\x0d\x9a\xfd\x2d\x71\x9a\xce\x9a\xc8\x44\x23\x34\x87\x2b\x27\xc3\x99\x12\xce\x62\xe3\x8d\xf0\x1d\x79\x2f\x14\x1d\x43\xd3\xde\x3a\x5e\x61\xb7\xc2\x92\xeb\xf3\x2f\xc7\xde\x73\x34\xfc\x6e\x14\x7b\x89\xc3\x5b\x68\xee\x2b\xc1\x17\xd5\xbe\x66\x0f\x15\x3b\xf2\x64\x89\x7c\x8d\xdf\x57\xf8\x23\xfd\x35\x59\xd7\xf9\x83\x53\x92\x93\xe7\x8a\xef\xeb\x1a\x4f\x3a\x45\x5b\x50\x23\x8e\x12\x60\xbf\x55\xf7\x8a\x06\x8e\xf6\xfa\x12\xcb\x38\x91\x33\x3a\x79\x86\xc3\xfd\xee\x67\x62\xf7\x84\xe4\x13\x53\xbf\xe0\xb3\xf0\xcc\x3d\x1c\x1e\xd6\xff\x92\x91\xc3\xd9\x16\x68\xdb\xff\x74\x19\xc4\x8b\x09\x41\xdb\xdd\xd6\xb7\x32\xd4\x4b\x93\xdc\xa3\xe9\x3f\x53\x75\xf6\xa3\x56\xd1\xda\x2d\x7a\x59\x1a\xcb\xfc\x52\x7c\x35\x72\xb1\x0b\x9a\x18\xf5\xf1\x62\x36\x53\xca\xd0\x6d\x55\x14\x3d\xe6\x29\x9f\xd9\x19\x74\xd5\x01\x11\xba\x24\x41\x22\x79\xe8\xb6\xeb\x29\x6b\x09\xbd\x04\x31\xee\x91\xe3\x40\x03\x87\xf2\xb2\x5b\x40\xe8\xb6\x43\xba\x48\x9f\xc4\x7b\x4c\x32\x8c\xb6\x16\x8d\x36\x92\x15\x25\x3a\x8a\x48\xce\xe4\x99\x8c\xe1\xf7\x00\xe6\xa9\x72\x33\x1a\x03\x00\x00")
 
 func dataFilterstatesCsvBytes() ([]byte, error) {
 	return bindataRead(
@@ -90,7 +120,7 @@ func dataFilterstatesCsv() (*asset, error) {
 	return a, nil
 }
 
-var _dataInvcategoriesCsvBz2 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\xf1\x01\x0e\xfe\x42\x5a\x68\x39\x31\x41\x59\x26\x53\x59\x42\x3e\x94\xad\x00\x00\xfc\xdf\x80\x00\x12\x48\x06\x7f\xe0\x3f\x2f\xde\x80\x3f\xef\xdf\xa0\x40\x01\xdb\x69\xb1\x94\x1a\x11\xaa\x78\x94\xd9\x3c\x80\x14\x68\x03\xca\x34\x18\xd4\xd3\x6a\x0d\x34\x10\x9a\x14\xc6\xa6\xd0\xd4\x69\x91\xa0\x00\x00\xd0\x66\xa9\x10\xd0\x00\x68\x0d\x00\x00\x34\x00\x18\xd0\xd0\xd0\x01\x90\xd0\x00\x00\x00\x01\x26\xd6\xda\x52\x0c\x40\x98\x03\x40\xd8\xc0\x6c\x9f\xf4\xe7\x9e\x54\xbd\xc6\xa2\xaf\x24\x19\x28\x07\xe3\xac\x11\x65\x74\x4c\x23\xa0\x4d\x0c\xa9\x89\x49\xde\x2a\xda\xf1\xc6\xaa\xcd\x55\xd7\x58\x76\xb6\xe8\x7a\x39\xe1\xba\x71\x8d\x53\xc6\x51\x01\x6a\xa4\x41\xa4\x14\xc0\x77\x43\x10\xc7\x76\xe9\x25\x52\x51\x4a\x92\x56\xbb\x15\xf7\x54\xd7\x52\x04\xd8\x51\x2b\x65\xb5\xb6\xd1\x20\x88\x90\xed\x39\x5c\xa1\x20\x96\xa3\x4c\x10\x8a\x28\xf7\x4a\x12\x40\x81\x2b\x26\xd0\x51\x0b\xc8\xb2\x4c\xc9\xe1\x92\x6c\xfa\xdc\x29\x83\x4a\x51\xa8\x06\x44\x20\x69\x43\x80\xef\xdd\x84\xc2\x8d\x0c\x8c\x82\x6d\x21\x0f\x00\xd4\x05\x31\x02\x3b\xd2\xe1\x96\x20\xb4\x2c\x72\x52\x33\xe6\xc7\x68\x85\xcd\xfa\x0e\x1d\x15\xa3\xf4\x7f\x66\x77\xd2\xc6\x86\xac\x25\x74\xd4\xb0\xdc\x48\xe0\x0c\x32\x4d\x0b\xc0\xdc\x62\x01\x08\x12\xfa\xa1\x10\x09\x33\x53\x2c\x9b\xca\xcc\xee\xb9\x6c\x13\x0f\x97\x9b\x04\x9f\x54\xd4\x28\xea\x63\xf1\xd1\xf0\xdb\x3b\x67\x45\x81\x52\x71\xad\x03\xde\x56\x8a\x1b\x27\xe9\x60\xdb\x08\xe1\x45\xca\xab\xc7\x95\x1d\x6b\x89\x10\x32\xae\xf8\x47\xe4\xda\x20\xd9\xf6\x06\x9a\xd9\xce\x0c\x03\xa9\x62\x94\xfa\x70\xb8\x0d\xc3\xbd\x50\x74\xaa\x80\x00\xe3\x08\xc2\xa1\x66\x55\xb6\xb6\x53\xd9\xb6\xd1\xd6\x30\xe8\x16\x81\x2e\xc0\x11\xca\x20\x19\xf7\x71\xca\xf0\xd8\x59\x32\x5a\x77\xeb\x1b\x6f\x3c\xfc\x90\xa5\x42\x51\xaf\xa6\x1a\xce\x06\xc6\x21\xa6\x31\x34\xf7\x34\x98\x05\x23\x41\x7b\x94\x38\xd2\xf7\x5f\x00\x3c\x53\x1c\x4c\x60\x70\x0a\xbb\xe4\x76\xa5\xd4\xd7\x24\x32\x16\x0e\x13\x66\x12\x53\x35\x2f\x84\x4c\x90\xcf\x3a\x28\x0d\x41\x39\x70\xcc\x06\x65\x0d\x86\x70\x28\xba\x66\x8e\x85\x18\xc5\xff\xe2\xee\x48\xa7\x0a\x12\x08\x47\xd2\x95\xa0\x01\x00\x00\xff\xff\x07\x08\xdf\x20\xf1\x01\x00\x00")
+var _dataInvcategoriesCsvBz2 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\xf1\x01\x0e\xfe\x42\x5a\x68\x39\x31\x41\x59\x26\x53\x59\x42\x3e\x94\xad\x00\x00\xfc\xdf\x80\x00\x12\x48\x06\x7f\xe0\x3f\x2f\xde\x80\x3f\xef\xdf\xa0\x40\x01\xdb\x69\xb1\x94\x1a\x11\xaa\x78\x94\xd9\x3c\x80\x14\x68\x03\xca\x34\x18\xd4\xd3\x6a\x0d\x34\x10\x9a\x14\xc6\xa6\xd0\xd4\x69\x91\xa0\x00\x00\xd0\x66\xa9\x10\xd0\x00\x68\x0d\x00\x00\x34\x00\x18\xd0\xd0\xd0\x01\x90\xd0\x00\x00\x00\x01\x26\xd6\xda\x52\x0c\x40\x98\x03\x40\xd8\xc0\x6c\x9f\xf4\xe7\x9e\x54\xbd\xc6\xa2\xaf\x24\x19\x28\x07\xe3\xac\x11\x65\x74\x4c\x23\xa0\x4d\x0c\xa9\x89\x49\xde\x2a\xda\xf1\xc6\xaa\xcd\x55\xd7\x58\x76\xb6\xe8\x7a\x39\xe1\xba\x71\x8d\x53\xc6\x51\x01\x6a\xa4\x41\xa4\x14\xc0\x77\x43\x10\xc7\x76\xe9\x25\x52\x51\x4a\x92\x56\xbb\x15\xf7\x54\xd7\x52\x04\xd8\x51\x2b\x65\xb5\xb6\xd1\x20\x88\x90\xed\x39\x5c\xa1\x20\x96\xa3\x4c\x10\x8a\x28\xf7\x4a\x12\x40\x81\x2b\x26\xd0\x51\x0b\xc8\xb2\x4c\xc9\xe1\x92\x6c\xfa\xdc\x29\x83\x4a\x51\xa8\x06\x44\x20\x69\x43\x80\xef\xdd\x84\xc2\x8d\x0c\x8c\x82\x6d\x21\x0f\x00\xd4\x05\x31\x02\x3b\xd2\xe1\x96\x20\xb4\x2c\x72\x52\x33\xe6\xc7\x68\x85\xcd\xfa\x0e\x1d\x15\xa3\xf4\x7f\x66\x77\xd2\xc6\x86\xac\x25\x74\xd4\xb0\xdc\x48\xe0\x0c\x32\x4d\x0b\xc0\xdc\x62\x01\x08\x12\xfa\xa1\x10\x09\x33\x53\x2c\x9b\xca\xcc\xee\xb9\x6c\x13\x0f\x97\x9b\x04\x9f\x54\xd4\x28\xea\x63\xf1\xd1\xf0\xdb\x3b\x67\x45\x81\x52\x71\xad\x03\xde\x56\x8a\x1b\x27\xe9\x60\xdb\x08\xe1\x45\xca\xab\xc7\x95\x1d\x6b\x89\x10\x32\xae\xf8\x47\xe4\xda\x20\xd9\xf6\x06\x9a\xd9\xce\x0c\x03\xa9\x62\x94\xfa\x70\xb8\x0d\xc3\xbd\x50\x74\xaa\x80\x00\xe3\x08\xc2\xa1\x66\x55\xb6\xb6\x53\xd9\xb6\xd1\xd6\x30\xe8\x16\x81\x2e\xc0\x11\xca\x20\x19\xf7\x71\xca\xf0\xd8\x59\x32\x5a\x77\xeb\x1b\x6f\x3c\xfc\x90\xa5\x42\x51\xaf\xa6\x1a\xce\x06\xc6\x21\xa6\x31\x34\xf7\x34\x98\x05\x23\x41\x7b\x94\x38\xd2\xf7\x5f\x00\x3c\x53\x1c\x4c\x60\x70\x0a\xbb\xe4\x76\xa5\xd4\xd7\x24\x32\x16\x0e\x13\x66\x12\x53\x35\x2f\x84\x4c\x90\xcf\x3a\x28\x0d\x41\x39\x70\xcc\x06\x65\x0d\x86\x70\x28\xba\x66\x8e\x85\x18\xc5\xff\xe2\xee\x48\xa7\x0a\x12\x08\x47\xd2\x95\xa0\x03\x00\x07\x08\xdf\x20\xf1\x01\x00\x00")
 
 func dataInvcategoriesCsvBz2Bytes() ([]byte, error) {
 	return bindataRead(
@@ -110,7 +140,7 @@ func dataInvcategoriesCsvBz2() (*asset, error) {
 	return a, nil
 }
 
-var _dataInvgroupsCsvBz2 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x73\x2c\x8c\xd3\x42\x5a\x68\x39\x31\x41\x59\x26\x53\x59\x15\xd6\xcb\x1f\x00\x58\xc8\xdf\xb1\x40\x12\x58\x86\x7f\xf0\x3f\xff\xff\xf0\x3f\xff\xff\xf0\x00\x20\x01\x00\x10\x00\x60\x42\x9c\x00\x03\x8d\xeb\xd3\x8d\x33\x27\xd3\xbb\x74\x1b\xba\x3b\x91\x6f\x7c\x03\xee\x0f\x3b\x20\x1d\xf6\xfa\x79\xec\x77\x2f\x80\xfa\x09\x00\x01\xdd\x9a\x13\xef\x75\xde\x78\xf0\xe5\x9d\x81\x25\xde\xad\x95\x7b\x3c\x78\x4a\x68\xd0\x48\x0d\x68\x19\x0d\x53\x7c\xee\xa0\xfb\xb8\xe9\x1d\xb0\x70\x00\x00\x00\x00\x0e\x3e\x90\x07\xc9\xab\x14\x08\x7a\xd4\x25\xb8\x01\xf3\x39\x8e\x3d\x2f\xbc\xdc\xa5\x00\xcb\x90\x16\xcb\xb2\x84\xb3\x76\x63\xbb\x58\x73\xb3\x41\x86\xe8\x96\x8e\x04\xe8\xf3\xe5\xbe\xbe\xb6\xe6\x3a\xa4\xa9\x76\xa8\x4f\xbb\x70\x69\xa0\x9a\x62\x93\x4d\x20\xa4\x7a\x7a\x99\x27\xa2\x00\x00\x00\x06\x80\x01\xa9\xe4\x06\xa4\x90\xa7\xa5\x26\xf5\x46\xd2\x64\x0f\x53\x40\x68\xd3\x43\x40\xc8\x00\x34\x12\x69\x22\x08\x49\x3d\x05\x4f\xca\x69\x0f\x28\x01\xa0\xd0\x34\x00\x34\x00\x04\x9e\xaa\x51\x4f\x4d\x20\xa7\x8a\x34\x68\x03\xd4\xf5\x06\x83\x11\xa6\x4d\x01\xa3\xd2\x00\x04\x49\x00\xa6\x92\x36\x95\x4f\xda\x9a\x53\x1a\x3d\x4f\x54\xfd\x43\x4c\x49\xea\x7a\x43\x10\x62\x30\x08\x11\x24\x40\x40\x4c\x9a\x68\x04\xc9\xa0\xa7\xa9\xea\x34\x46\x9a\x03\x40\x06\x8d\x3c\xde\x6e\xb6\xdb\x5a\xd7\xc9\xe7\xdd\x26\x26\xc9\xb5\x28\x01\x66\x58\x44\x31\x8b\x46\x28\xa8\xd6\x8d\x6b\x16\x66\xda\x31\x29\x54\x60\x45\x91\x66\x96\x13\x58\x86\x42\xb4\x5a\x29\x49\x51\xa2\x42\x98\x6a\x54\x54\x8b\x19\x33\x16\x34\xa5\x4a\x95\x8d\x92\x62\xdb\x16\x8a\xc3\x4b\x31\xa6\x4a\x6c\xb0\x68\xd9\x94\xc0\x62\x94\x46\x9a\x26\xb2\xcd\x8b\x45\x99\x66\x84\xc1\x0c\xc0\xd4\xcc\x54\xb2\x64\x52\x2c\x53\x52\x4c\xa8\xd2\x52\x11\x05\x8d\x92\xac\x51\xa2\xb3\x64\x46\xd2\x22\x62\x93\x51\x4b\x46\xd6\x14\xd1\xa3\x45\x8b\x31\x6a\x32\x8b\x46\xb6\x62\x31\x6d\x85\x19\xb6\x89\xb4\x55\x18\xb4\x2d\x12\xc5\x35\x19\x85\x22\x64\x4c\x44\x86\x19\x6c\xd4\xa0\x32\x9a\x0a\x16\x14\x3e\x8e\x9e\xef\x6f\xe2\x88\x89\x71\xc8\x22\x22\x8e\x73\x72\xf9\xb9\x72\x20\xad\x45\x56\xb4\x66\x5c\xb4\xc6\x2a\xcc\x4a\xc5\x0c\xb7\x1b\x52\xc6\x5d\x6b\xf4\x6a\x69\x0c\xd8\x66\x4a\x31\x16\x61\x41\x0f\xd3\xaa\xdf\xc2\xd9\xd7\xf9\xff\x71\xff\x5b\xfb\x04\x44\xff\x6f\xd8\xdc\xe3\xf4\x31\x55\x6e\xf8\x39\xd5\xc9\xfe\x9d\x65\xb6\xff\x8f\xc7\x16\xd2\x2a\xf2\xcc\x41\x65\x4f\x0c\x25\x97\x9b\x51\xfe\x81\x0e\x58\xcc\x10\x47\x73\x14\xf1\xa5\x83\x6a\x1b\x83\x54\xde\x65\x43\xb6\x12\xba\xcd\x04\xd0\x7e\xee\x26\xed\xb0\x73\x44\xce\x37\x2d\x2f\x55\x68\x3c\xd5\xea\xf0\x39\x52\x14\xcc\x21\x28\x43\x46\xfe\xef\xf8\xd7\x44\x7f\xdd\x8d\xef\xfb\x74\xef\xc7\x01\x4a\x90\xa6\x02\xf5\xeb\xd3\xa1\xe0\x00\xda\xf7\x85\xad\x08\x3e\xad\x67\xb4\x11\xb3\xe1\x69\xbf\xa1\x15\x32\x3e\x65\x18\x91\x04\x90\x86\x55\x49\x23\xc2\x4c\xb8\xaf\xee\xe1\x8b\xd4\x0f\xa9\xfb\x95\xec\xa3\x0d\x0e\x2a\xde\xd7\x64\x0f\x27\x44\x0b\x0a\x25\x80\x50\x09\x0b\x8b\x70\x15\x8a\x6b\xcc\x2a\x4d\xca\xec\xb3\x92\xbc\xb4\x1f\xa7\x7c\xe7\x7b\x0a\x2a\x88\xaa\xf0\x2b\x74\x5f\xf8\xca\xf5\x6e\xf7\xc6\x4e\x36\x74\x70\x55\xf5\x03\x91\xcb\xe3\x1d\x76\xf4\xbc\x4f\x77\x2f\xd6\xe9\x70\xf8\xe1\x63\x15\x46\x39\xc7\x06\xb1\x9d\xb2\xb4\x9c\x83\xf6\x62\xaa\xf5\xd6\x6c\xe0\x3e\x93\xfa\x78\x90\x73\xbb\xfb\x5a\xbc\x0b\x08\xb6\xa8\x7c\xf3\x9c\x17\x70\x57\xfb\x85\x03\x14\x0a\x15\xc0\x54\xdf\x06\x74\x7e\xa7\xbe\x97\xb3\xec\x59\x8c\x03\x8f\x1e\xfd\xf3\xd6\xe7\xc9\xa6\x9e\x4c\xa6\x2d\xa5\x72\xe6\x15\xcc\xa6\x39\x7d\xba\x94\xf8\x09\x4e\x11\x5f\x77\x8f\x7b\x76\x2c\xf1\x87\x1e\x76\x5f\x71\x52\x84\x82\x17\x48\x23\xee\x0a\x1a\x1e\x9b\x36\x36\x07\x1a\x60\xac\x35\xab\x05\x23\x92\xc4\x3f\x03\xbb\x8f\x78\xed\xa5\xbb\xdf\x3d\x05\x2a\x42\x96\x0b\x3e\xdf\x2c\xc5\x21\x28\x39\x90\xb8\x6a\xd4\x7a\x4a\xfb\x28\xc7\x79\xc1\xb3\x22\xbd\xc4\xea\x28\x2c\x9e\x9e\x50\x11\x3f\x1a\x28\x69\x97\xee\xd9\xb5\xf5\xc1\x5d\x18\x19\x44\x23\x21\x65\x7c\x7c\x53\xe1\x20\x49\x42\xef\x4f\xb0\xaf\x9b\x26\x2e\x06\x1e\x29\xfa\x6c\xe4\x15\xeb\xa9\x48\xda\x28\x1d\x75\xc6\xdf\xb3\x28\x09\x85\x38\x04\x94\x20\x7a\x05\xfd\xb9\x75\xdd\xa8\x30\x7c\x66\x9c\x66\xfa\xf8\x21\x1d\xfc\xf7\x71\xa3\xf3\x2e\x8f\xfb\x31\x0d\xdd\x23\xe4\x75\x6e\xdc\xb7\x84\x46\x10\x15\x74\x37\x60\x9c\xa2\x95\x60\xc6\x56\xde\x78\x60\x28\xc9\xaf\x9d\x62\x7a\x4a\x9f\xeb\x30\xb6\xd8\x56\xa3\xd9\x1e\x07\xd6\xd2\x83\xdf\xa4\x0a\x11\x2a\x9d\x7d\x59\xea\x06\xa4\x72\x4b\x07\xd6\xaa\x34\x7b\xe5\x22\xe3\xc4\xc6\xf6\x14\xa9\x0a\x58\x2e\x47\xbb\xdd\x1b\x42\x50\x4f\x6d\x86\xe0\xbd\xab\x03\xe9\x2b\xdb\x86\xd9\x2b\xe8\x97\xec\xc5\x55\xbf\x1e\x73\xdf\xcf\xb8\xa7\xd9\xd1\x3e\x1e\x40\xf9\x07\x5b\xc5\x48\xea\xd4\x5e\x3d\xd3\x26\x6f\xec\x05\x12\x92\xfb\x08\x9d\x1d\x39\x53\x57\x09\x19\x16\x44\x10\xe5\x72\xa3\xd0\x40\x40\x76\xd0\xaa\x9e\xf5\x63\x4a\x79\x87\x25\xbd\x8b\x31\xe4\x6b\x93\xd9\x2f\x07\xc3\xe4\xde\x04\xc5\x23\xbc\x44\xce\xd2\x2b\xcb\x8c\x92\x58\x2f\x7c\xe5\x3f\xd9\x48\x1c\x2d\x49\xe1\x2d\x96\xf3\x78\x69\x83\x1e\x7e\x73\x03\xc2\x8d\x7a\x90\x02\x01\xd9\x1b\xbd\x40\x46\x19\x08\xcc\xd1\x19\x8c\xed\x23\xbc\x12\x4a\xa3\x8d\xf9\x15\x5d\xfa\x55\xab\x11\xc7\x11\xea\x01\xf5\x28\x49\x24\xe0\x13\xf0\xc6\x25\xae\x9d\x01\x0a\xc5\x3c\xfa\x0d\x09\xae\x5d\xa8\x89\x30\x3f\x42\x87\x28\x18\x11\x07\xb1\x42\x62\xf5\xe4\x43\xe8\xcb\x04\x7f\x08\xf2\xc0\x04\x5d\xe0\x4a\x44\x1f\x17\x96\x05\x53\xdf\x19\xb1\x66\x0a\xeb\x89\x20\x37\x81\x41\xbf\x9f\x26\x74\x5e\x83\x7a\xbf\xbf\xdf\xce\x91\x1e\x51\x87\x66\x66\xde\xc0\x23\x4e\xaf\x6e\xda\xf2\x1c\x07\x8b\x0a\xa4\x12\x44\x28\x1c\x57\xf6\xee\x45\x7d\x2f\xeb\x0a\xb9\x7c\x4a\x89\x89\xf0\x4f\x00\xe7\xd2\xf9\xb3\x77\x2a\x7d\xfb\xc1\xcb\x6d\x2e\x96\xf3\xec\x1e\xb5\x27\x1e\xdf\x0e\x7d\x3c\x0c\xe3\x85\x54\x61\x46\xc9\xf5\xfb\xd0\x58\xba\x9d\xe3\x6d\xc5\xa4\xa2\x69\x40\xfb\xba\xde\xd8\x60\x9b\xe2\x61\x27\x9b\x9c\x37\x84\xa6\xcc\xb9\x72\x54\x50\x48\x54\x86\x61\xb3\x0a\x61\x51\x34\x08\xe4\xa6\xe6\xb2\x22\x69\x9a\x86\xa5\x35\xb7\x58\x37\x65\xc1\xf6\xb0\xe3\x93\x6a\xc8\xaa\xa0\x9c\x3a\x9a\xcc\xd2\xbb\x29\x2d\xb5\x1b\x54\xf6\xd6\x8f\x97\xc3\xaa\x66\x66\xa1\x64\x32\x26\x82\xda\x3a\xa2\x11\x8f\x9c\x9e\x0f\x0e\x8c\x44\xb8\x52\x65\x42\x2a\x98\x82\x0c\x2e\x76\x1e\x7e\xcf\x73\x92\x28\x66\x2e\x7d\x05\x76\x39\x96\x7d\xe1\x24\xbd\x18\x84\x92\x49\x25\x2e\x4c\x7d\xc0\x0b\x9b\x98\x17\x7f\x81\x6d\x7d\xff\x7b\x26\x02\x1c\xf6\x46\x97\x38\x3d\x38\xe6\x69\x24\xb2\x34\xf5\x43\x8f\xaa\xf6\xf3\xbf\x8b\xc2\xca\x97\x51\xf4\x22\x02\x3b\x30\x1a\x1e\xc8\x8a\x35\x1e\x95\x7b\xdc\x82\xaf\xcc\x4f\xd1\x4d\x1d\xc8\x80\x3a\x10\x15\xa5\xc2\xb3\x02\x9a\x2b\x69\x2f\x1d\x04\x61\xa8\x4d\xdb\xb0\x67\xc2\x66\xd7\xd0\x1d\x6b\xce\x58\x33\x33\x7b\x6f\x80\x8c\x03\x8d\x3f\x70\x7e\x35\xf1\x0c\xaa\x35\x7c\x23\x93\xcf\x74\xd9\x91\x27\xe3\xe1\x0c\x53\x53\x5e\xdb\xf3\xb9\x17\xf6\x2d\x40\x18\x7f\x39\x83\x07\xef\xad\x4c\x9a\xfa\x5f\x6e\xbe\xe7\x9d\x55\xb9\x96\xdb\x8f\xc4\x0b\xe8\x03\x77\x76\xf9\xf5\xd3\x00\xda\x4d\x5f\x16\x0b\xb7\xa8\x6e\x7a\x7a\xf7\xc1\xf9\x1d\xfc\x3c\xc0\x68\xb8\x7e\x93\xe9\xff\x5a\x85\x2f\xf9\x41\x64\xaa\x93\x56\xb6\x35\x26\xd5\x6c\x5b\x26\x36\x36\x8d\xaa\xd8\xd6\x2b\x49\x18\xdb\x6d\x8b\x56\xc5\xb4\x6b\x58\xb5\xac\x54\x54\x11\x8c\x51\xaa\x22\x99\xac\x54\x51\x46\x99\x06\xda\xd8\xd4\x6b\x59\x4d\x8b\x45\x45\x5a\xa3\x5b\x68\x35\xa6\xc9\x22\x48\xa3\x51\xb5\xb5\x9d\x7e\x55\x55\xd1\xb6\xfd\x9c\xe6\xd5\x45\xaa\xea\xda\xe3\x60\x23\x43\x22\x8c\x69\x71\xc4\x67\x4e\x56\xe2\x50\x2d\xdb\x9c\xb6\xdf\x33\x5a\xe2\xd6\xc6\xd6\x91\x11\x90\x20\xc8\x11\x91\x61\xd3\x4e\x56\xec\xf6\x9d\xbe\x4e\x01\x6a\xeb\x8e\x13\xba\x39\xd9\x75\x73\xa7\xb7\x3e\x9d\xe6\x57\x33\x5a\xfd\x5c\xdf\x8b\x6d\x96\xb1\xb4\xad\x4a\xd4\xad\x42\x69\xc4\xc6\xa5\x6a\x56\xa5\x6a\x56\x2d\x65\x4a\x95\x2a\x56\x2c\xad\x65\x6b\x2a\x22\x29\x5d\x5a\x2c\x8b\x89\x72\xca\xc7\x51\xba\x54\xae\x1e\x2e\x93\x56\x0e\x8d\x32\xa6\xbc\xb9\xcd\xbd\x46\x91\x3e\x6d\xf5\xce\x67\x4b\x93\xea\x5f\x5a\x36\x82\xaf\xb2\x5e\xf9\x33\x2d\xf6\x7b\x13\x49\xac\x2a\xcc\xf5\xa0\x3d\xa4\x48\x22\x7a\x86\xac\xae\x4e\x05\x1a\xeb\xf0\x9f\xac\x3d\x92\x46\xfd\xb1\xf6\x57\xab\x74\xb3\xac\xfa\xdf\x46\xe9\xf3\x60\x6c\x44\xe5\x6c\xa3\xec\x97\x2e\x14\x04\xa7\xcd\xf9\x3c\x04\x80\x01\x23\xea\x76\xb7\x1e\xfa\xdb\x5c\x16\x42\x2d\xa7\xed\xb9\xae\x34\x6b\x58\x00\x58\x41\x0d\x7d\x69\xfd\x56\xea\x29\x9b\xfe\x0d\x1a\x64\x0b\xf7\xdd\xa7\x0c\x58\xf5\xf7\xe5\x67\x6e\x77\x98\x1a\x71\xfc\xa9\xa6\x4d\xf3\x42\x18\x32\x13\x48\xb0\x87\x0c\xbc\xb8\x65\xd1\x6e\x34\x6b\xde\xf7\xbd\x37\x5f\x21\xa3\x4c\x48\xd4\xb1\x49\xa5\x16\x20\x26\xa2\xa5\x28\xa1\xa8\x41\xb3\x31\x46\x68\x49\x12\x49\xb2\x65\x28\xa6\x99\x90\x98\x62\x68\x98\xa5\x14\x6a\x24\xd3\x22\x14\x51\x14\xb5\xb2\x96\xa9\x4d\x66\x31\x8d\x8d\x2c\x99\x2d\x96\x80\xca\x40\x98\xcb\x14\x23\x22\xd1\x8a\x52\xda\x34\x25\x65\x9a\x86\xb3\x6d\x06\xa1\x51\x98\x23\x16\x48\x11\x84\x48\x41\x91\x70\x37\x42\x99\xd9\xca\x8a\xe7\x39\x4c\x6e\x9c\x05\x50\x07\x55\xcd\xc7\x27\xa2\x35\xbe\x8f\xe5\x91\x49\x5b\xd5\x13\xb7\xf2\xb0\x4d\xbe\xd6\x75\xba\x44\x44\x86\xd6\xb4\x5b\x58\xd5\xb4\x6a\x53\x56\xa9\xa8\xa4\x64\x88\xa8\xf4\x41\xf6\xf9\x4b\x7a\x92\xd6\xb5\xbc\xfc\x53\xeb\x90\xbc\xd0\x21\xb8\xa9\x16\xda\x36\xdb\x16\xda\x36\xab\x50\x55\x16\xcc\xa8\x8a\x66\x28\x58\x9a\xd0\x5b\x6a\x0b\x6a\x9a\x40\x80\xab\x05\x5f\x1c\x40\xb0\x82\xf1\xf4\xe9\xd2\x54\x80\x7d\x3c\xeb\xef\xf5\xbe\x04\x22\x20\xa9\xab\x0a\xc1\x65\xb4\xf3\x4f\xaf\xb7\x5e\x33\x9c\x77\xe2\xd2\x1d\x23\x21\x4e\xd1\x49\xbb\x74\x90\x90\x47\x57\x64\x93\xef\x36\x46\xd0\x4e\xa4\x2b\x14\x0a\xec\xb5\x5b\x34\xc6\xaa\x4a\x92\xd8\x61\x6a\xb1\xb5\x59\x64\xc5\x2a\xd8\xc1\x50\x5a\xa3\x6a\x34\xd2\x8d\x6b\x1a\xd8\xb1\x99\xa3\x46\x8a\x69\x12\x48\x20\x9a\xf1\x11\xa6\x08\x54\x1f\x58\x81\x6f\x59\x45\xa5\xe0\x9e\xb0\x34\x35\x8d\xfa\xe1\x97\xeb\x9a\x72\xf5\x9f\x55\xc0\x2b\x04\x40\x7b\x4b\x11\x11\x86\xac\x31\x0c\x8c\x23\x0a\x90\x9f\x76\x5e\x98\x4e\xd3\x3b\xb5\x80\x69\x90\x29\x62\x0f\x68\x20\x51\x08\x44\x09\x11\x03\x5b\x99\xad\xef\xad\x4f\x12\x40\x40\x3e\xb7\x99\x7d\xf2\x57\xaf\x55\xeb\x3d\x19\x9b\xc2\xb3\x73\x76\x32\x58\xbb\x62\x0c\x42\xc5\x56\xdb\x66\x81\xca\xa9\x9a\x0f\x5d\xb6\x77\x23\x33\x36\x68\x55\x1d\xba\xd0\xe6\x15\xca\x9a\x51\x57\x9b\x58\xe2\x72\xaa\xab\x2a\xaa\xa7\x5e\x4d\x1a\xcc\x99\xac\xbb\xbb\xca\xaa\xaa\xca\xcd\xcc\xcd\x18\xb3\x0a\x99\x3b\x35\xb5\xa9\xc4\xb8\x45\x46\xc2\x88\xdd\xd9\xa7\x59\x1a\x1c\x16\xe7\x73\x5d\xd6\x1d\x4f\x06\xec\x41\xbb\x42\xb6\x71\x68\xc3\x22\x6f\x25\x3a\xa8\xb7\x8e\x2e\x4b\xba\xcb\xa4\x26\x86\xc6\xb5\xb1\x91\x31\x97\x93\x35\x97\x92\xb5\xcd\x2d\x6e\xea\x96\x63\x55\x90\xf6\xe2\xf2\xde\xd7\x37\x75\xd6\x6c\x64\xb9\xc3\x57\x0b\x28\xd6\x6f\x9e\x11\xf4\xe2\xf9\xe7\xb8\xe4\x85\x00\x86\x06\xb8\xbe\x2e\xf8\xe7\x9e\x6f\xec\xf9\xa0\xec\xdd\xc0\x24\x31\xf4\x75\x67\x61\xcf\x39\x6b\xdf\xb1\x53\x5e\xd2\x3e\x3e\x25\x44\x54\xef\xc7\x7c\x04\x8f\xa3\x9a\x69\x78\xb5\x47\xa9\xec\x13\x79\xeb\x6d\x65\xcc\x8f\x51\x55\xa9\xe8\xd4\xc1\x79\x85\xc7\xcf\xc6\xb9\xf1\x47\x99\x01\x43\xce\x7b\x11\xcf\x8d\xb1\xba\xbd\x9f\x7d\x5f\x90\x94\x28\x9f\x6c\x1b\xa8\x88\x7a\xad\xd1\x49\xd5\x8a\x15\x09\x55\xc1\x7a\xa4\xe2\x87\x8b\x73\x75\xbd\xd6\x92\x73\x1a\x63\xdf\xdb\xe9\x7b\x9d\x47\xcf\x3a\x7b\xeb\xde\x15\x4f\xb6\x8a\x93\xdd\x93\x1d\xee\x04\x9b\xcc\xad\x33\x03\x64\x5d\x1a\x93\x95\x70\x6a\x20\xd5\xb1\x90\xd9\x3a\xdb\x6d\x5e\xf5\x35\x81\xf9\x1e\x0f\x81\xee\xeb\xcb\x47\x9b\x3d\xa7\xae\x3a\x1e\x23\xa5\xdf\x5a\x89\xf1\x6d\xe5\xeb\x31\xad\xf2\x3b\xf5\xbf\x9b\xa0\xf0\x79\xeb\xaa\xe4\x47\x65\x8e\xe3\x95\xe4\x28\xa8\x1a\x82\xf1\xb5\x0b\xe6\x91\xa6\x4b\xa7\x68\xb6\xc3\x14\x25\x1a\xf9\xb5\x2a\x99\xcd\x75\xbb\x85\xde\xd5\xc6\x21\x75\x59\x59\xb2\xfe\xde\xb6\xf4\xde\xd7\x7b\x27\x33\x64\xc1\x1d\xb6\x46\xb4\x25\x6d\x22\x2b\x4e\x62\x9b\x87\xb6\xea\x04\xce\xcc\xb8\xb1\x28\xa8\x88\x9c\xc8\x8b\xcc\x78\xe2\x77\x36\x2e\x73\x67\x55\xe6\xba\xa4\x8c\x5c\x3d\x8c\x6e\x66\x1d\x66\x66\x45\xc4\xa2\xa5\x18\xcd\x6f\x43\x6e\x75\xb9\xa8\xbb\xd9\x89\xab\xd2\x75\x82\xdb\x73\x4d\xe4\xa8\xab\x6d\xe9\x86\xf3\x26\x6e\x6d\x28\xbd\xdc\x87\x55\x35\xb1\x46\xf5\x3b\x54\xc4\xcc\xde\x03\x74\x9c\xea\xd2\x14\xe3\x88\x17\x13\x6e\xf2\xdc\x2a\x9c\x46\x22\x08\x83\x11\x2f\x54\xac\x9d\x19\xb2\x16\xce\x3b\xb7\x33\x73\xae\x46\x25\x35\xb7\xa2\x2d\x19\xcc\xa8\xcd\x6c\x39\x8c\x90\xe8\x46\xab\x8b\x9c\x2d\xd5\x5c\x2c\xb3\x4d\xb3\xb5\x22\x37\x68\x42\x0e\x52\xc1\xad\xd2\xab\xca\x32\xd5\xce\x44\xaa\x79\xb3\x26\xe4\x7b\x7b\x7b\x82\x3b\x5d\xf5\x1b\x0f\xc2\x7d\x59\x33\x74\xa8\xfa\xa3\x57\x11\xe9\xd4\xb8\xb6\x1a\x6a\x46\xba\x73\x86\xdb\xcd\x98\xac\xb8\x5a\xb3\x2e\x05\xd2\xbf\x07\x80\x92\x49\x79\x9e\x0b\x8f\x3c\x57\xde\xc7\x84\x54\x35\x14\xae\xf7\x23\x5c\x39\xb9\xb0\xf4\xbc\x71\x53\x54\x71\x0a\x91\x13\x1a\x2a\x62\xa4\xa4\xb0\x9a\xb3\x4e\xa8\xdd\x43\xc3\x51\x2e\x5e\x29\xe1\xda\x30\xb3\x26\xae\xf7\x77\x72\x0d\x56\x8b\x2a\x0d\x44\x46\x56\x6e\xba\xb1\x59\x59\x52\xe2\xb1\xa9\x9d\x9c\x90\xdb\xd3\x6b\x75\xc5\x36\x76\xe9\x51\xd7\x4d\x0c\xdb\x71\xe7\x94\xe6\xb1\xd7\xa1\x8a\xbd\x1b\x85\x1b\xe8\x0f\x33\xc6\x62\xa2\xa9\x35\xbe\x0e\x58\x65\xe4\x39\x79\x5d\x37\x9b\x45\x55\xf4\xbb\xe4\x5d\xc5\x59\x14\x4b\x62\xdd\x69\xb6\x89\x24\xd6\xac\x6b\x56\xe5\xf3\x7e\x64\xc6\x36\xce\x10\xb0\x90\x92\x22\x57\x11\x24\xd2\x4b\x45\x6c\xd1\xaa\x8d\x32\x49\x55\x14\x71\x44\x0a\xb5\x22\x2e\xf0\x51\x75\x9b\x50\x51\x99\x10\xb6\x0c\x0a\x25\x1d\x3b\x93\x6c\x0e\x41\x00\x6f\xa2\xe6\x72\xd4\xd8\x04\xb9\x0c\x0e\x33\x9e\x3a\xcd\xbb\xe4\x75\x97\x26\xb1\x82\xe5\x70\x89\x24\x8a\x90\xe7\x61\x3b\x0a\xc8\xa8\xb8\x40\x9a\xbc\xca\xa2\x6a\xa3\x26\x34\x33\xce\x00\x9d\x51\x06\x34\xc4\x13\x79\x37\x69\xa9\x83\x98\xa1\x2a\x95\xc4\x60\x22\xe1\x8e\x64\x5c\xe5\xd3\x98\x9a\x11\x11\x16\x33\x80\x0a\x14\x64\xe8\x84\x4a\xa8\x8a\xe0\x22\xf5\x29\x9e\x01\x9b\x55\x14\xb6\xc0\x1c\xd9\x96\xa2\x61\x31\x02\x62\x76\x34\xdc\x44\xe0\xa6\xac\xc6\xf1\xd7\x5a\x53\x9a\x6b\xbb\xcb\x8b\xb6\x94\xd7\x37\x22\xba\xb4\x93\x7a\x20\x07\x5a\x15\xed\x6d\xa1\x69\x6d\xb6\x8b\xc7\x35\x74\xf3\x9b\xde\x1d\xf4\x5e\x17\xed\xed\xec\xfd\x4b\x7b\xeb\xef\xde\x5e\xd7\xb3\xdf\x0b\xf2\xc2\xb0\xab\xc0\xae\xe2\xdd\x70\x34\xb6\x21\x6e\xe9\x95\x98\x31\xfe\x14\x68\x9c\x15\x92\x90\x90\xae\x90\x56\x07\xb0\xfd\x7e\xc9\x49\x1a\xb2\x49\x2c\x55\x43\x81\x22\x1a\xf5\xd9\x9c\xc9\x24\x2c\x80\x20\x58\x95\x1f\xd8\x94\x24\x92\x1e\xc0\x28\xb9\xbf\xe3\x8d\x72\x35\xb8\xd7\x08\xf0\xe3\xe9\x28\x36\x5e\xdc\x2e\x99\xb7\x08\xee\x39\x32\x45\x75\xa1\xa3\xc9\x82\xca\xb0\x59\x3d\xdf\x6d\x87\xa5\x44\x41\x9a\x22\x12\xe9\xef\x16\x5e\xa7\xdc\xce\x2e\xcd\xac\x36\xdd\x77\x86\x12\xf0\x90\xe5\x7e\x3c\x97\x64\xd6\xab\xc5\xda\x33\x0d\xcc\x0d\x88\x9c\xc7\xe4\xac\x18\x20\x6e\xb5\x6f\xb0\x07\x75\x24\x92\x61\x1a\x08\xcf\xab\x96\x70\x33\x69\xff\x63\xcb\x1b\x68\xde\xbb\xcf\xd5\x72\xb0\xdf\xdd\xf5\xce\xd7\xc6\x63\x6b\x05\xad\x4f\x52\x27\x8f\x16\xae\x74\xb0\x59\x6d\x99\xb6\xfb\xb0\xc2\x59\xb6\xdb\x2d\xa1\x7c\x61\x2d\x95\xd0\xf3\x9f\x3f\x8c\x02\xd1\x35\x80\xd1\x08\x6e\xe4\x16\x4b\x49\x1f\x08\x35\x14\x8c\x0d\xd5\xc2\xc6\xdc\x77\x60\x4b\x6f\xb6\x03\x19\x33\xcf\x4f\x65\xf7\xe1\xef\xf3\x50\xa4\x47\xf0\x0f\xba\x28\x58\x3f\x7a\xa0\xfd\xe8\xfc\x7c\x8c\x9b\xdd\x2c\xf2\x5e\xad\x82\x0a\xb5\x16\x51\x37\xb7\x75\x7b\x80\x90\xd6\xc5\x12\x49\x04\x93\x54\xac\x89\x23\x9c\x55\x9c\xe0\xb6\xb9\x71\x0a\xe9\x05\x95\x02\x06\x3d\x4e\xb4\x5d\x18\xc4\x4e\xc8\xba\x93\x48\x94\x41\x34\x51\x24\xe2\x83\x07\x14\x98\x24\x4b\x19\x92\x20\x72\x90\x57\x8e\x9c\x97\x12\x9e\x48\x1c\xcd\xae\xef\x79\xa3\x4b\xdb\xdc\x9c\x62\x6c\xa7\x02\x48\x63\x00\x2b\x24\x26\xad\x8b\x00\xc6\x1b\x71\x26\x25\x56\x57\x8e\x1c\xae\x34\xe2\x97\x76\xab\xa6\xad\xc3\x83\x6d\x44\xda\x49\x46\x67\x26\x61\x24\x94\xd1\x43\x4e\x59\xb8\x37\x1a\x09\x9e\x55\xe3\x6c\xef\x6b\x65\xa6\x26\x06\x5c\xf9\x7d\xa4\x9c\xea\xe1\xb6\x48\xbe\xe2\xa8\x7d\xf6\xf0\x34\xcf\xc2\x18\x53\x97\xc0\x80\x20\x7f\x18\x80\x00\xbd\x90\x0e\x5a\xd0\x85\xa2\x81\x50\x14\x57\xc7\xa5\x08\x80\xa1\x78\xea\x20\x80\x57\xb3\xc6\x2b\xd9\x59\xde\x7d\x0e\xd8\xc5\x6f\x1a\xd4\x44\x2c\x06\x2e\x2b\xa6\xb0\xe4\xcd\xdc\x51\x83\x6f\x12\x95\x34\x66\xde\xb1\x99\xb6\x23\x44\x15\x32\xe2\x8d\xd6\x64\xe6\xb8\x89\xa8\x6e\xf6\x6b\x45\xbc\x3b\x51\xb2\xa8\x18\x2a\x2e\x2e\xf2\xee\x0e\x25\x8c\xa3\xb5\x94\x4f\x07\x06\xae\x12\x38\x52\x29\x48\x36\xc6\xcc\xce\xce\xe0\xd5\xc0\x05\xa7\xc0\x01\xd2\x49\xe2\x87\x93\x13\x5a\x71\xe3\xbe\x00\x37\x81\x63\xaa\xcd\xdb\x4e\xdf\x00\x33\xa8\xcd\xed\x1c\xbd\xde\x01\x5a\x24\x6b\x39\xb1\x69\xeb\x20\x09\x61\xcb\xcb\x5b\x87\x5d\x00\x25\x8c\x7b\x77\x9b\xb6\xf2\x6c\x70\x30\xe5\x9c\xab\xd3\xae\x68\xbc\x98\xce\xb5\x37\xbc\x67\x71\x33\x13\x78\xce\x73\x8a\xde\xf7\x9d\x66\xfa\xa1\xec\x02\xa0\xf9\xc1\x10\xce\x2f\x39\xd6\x14\x00\x30\x73\x9a\xba\x0a\x12\xfd\xef\x6c\x77\xb7\x79\x2d\x6b\xf7\xe2\xd4\x7c\xcb\xdd\x89\xd5\x55\x98\xbc\x98\xc8\xa3\x96\xd1\x19\x74\xf5\xe6\x56\x4d\xca\xb1\xb8\x41\xd8\xd3\xa9\xf1\x29\x26\xe1\x0a\x12\xf6\x66\x71\xa1\x50\xc8\x33\x3a\x2d\x43\x17\x70\xcc\x5f\x1c\xbd\x67\x9b\xde\xef\x8c\xe6\xab\x06\xfa\xa4\x54\xa9\xa9\x6a\xa6\xa0\xa3\x44\x10\xa8\x49\x90\x19\x09\x8c\x80\x1e\xb8\xb8\xac\x26\x86\x36\xac\x95\x71\x54\x09\x07\x33\x0f\x1c\x34\x57\x1c\x3d\xe4\x5c\xe0\xb0\x88\x09\x04\xa2\x02\x15\x42\x28\x55\x1b\x4c\x86\x50\x52\x16\xd8\x5a\x1b\xdf\x7b\xcf\x1e\x7b\xdf\x7e\x73\xbf\x12\x48\x10\x7b\x22\xa8\xa8\x14\xc1\x09\xc3\xa9\x28\x4a\x04\x04\x84\x72\x80\x0b\xca\x6b\x17\x9c\x6f\x89\xe3\x54\xf4\xb9\x21\x88\x95\x04\x90\x42\x45\x4e\xa0\x42\x1d\x67\x73\x7b\xeb\x39\xe6\xb1\x8c\xf6\xe4\x93\xb4\x14\x14\x82\x2c\x8a\x78\xab\x63\x50\xef\xc7\x6f\x46\xb5\xae\xfc\x3d\x13\xa4\x1e\x20\x28\x0a\xaa\x28\x8c\x14\x15\xa0\x4d\xf9\xde\xf3\x9e\xf7\x39\x9e\x1a\x91\x90\x64\x02\x46\x41\x91\x91\x49\x10\xe6\x3a\xed\xae\x67\xae\xb5\x7d\xf8\xf3\xe0\x09\xd7\x94\x90\x59\x0b\x18\x62\x62\x0b\x05\x81\x92\xd2\x16\xd2\x98\xd7\x59\xde\x39\xd6\xbb\x4e\xc8\x0e\x15\x90\x03\xb1\x24\x24\x42\x41\x91\x90\x4d\x75\x96\xc3\x4c\x34\xd7\x5c\x26\x7a\xe7\xaa\x33\x35\x90\x0a\x0a\xa5\x24\x12\xaa\x83\x94\x95\x90\x58\x06\x0c\x95\x27\x8e\xb6\xf8\xf1\xe3\x7e\x7a\xe9\x7b\x6b\x0b\x10\x85\x40\x59\x22\x90\x52\x0b\x03\xcf\xae\x0f\x48\x8f\xa7\xce\x53\x6f\xae\x6f\x8e\xfa\xcc\xf4\x54\x4f\xa7\x90\x53\xb1\x7b\xa8\x2d\x53\x39\x90\x45\x49\xcb\xa5\x7a\x61\x1e\xa4\x5a\xb7\x0c\x54\x1a\xdc\x72\xd1\x9b\xcc\x88\xcc\x86\xd5\x56\xdd\x09\x90\x8e\xd4\x13\x5a\x33\x21\x8d\x2c\xe9\x7a\x91\x33\x96\x1c\x56\xcc\xcd\x45\xbb\xab\x85\xe8\x73\x88\xf0\x91\x87\x9c\x04\x21\x20\x14\x1c\x2b\x36\xd0\x85\x28\x00\xb9\xbd\xcd\x8c\xe2\xe7\x7c\x58\x01\x0c\x00\x49\x22\x0a\x82\x4b\x66\x2e\xa5\xb0\xde\xc5\xef\x52\xae\x94\x09\x24\xa1\xcb\xdc\xcd\x5b\xe6\x6b\x59\x9b\xbb\x01\x19\x4a\x9c\x24\x90\x49\x48\x99\xca\xcc\xdc\x4d\xd0\x38\x30\x20\x01\x90\x38\xd2\x8c\x8a\xdc\x69\x99\x72\x28\x52\x25\x41\x08\x66\x0c\xe5\x6f\x4e\xab\x93\x76\x80\x25\x82\x76\x01\x7b\x76\xf6\xbb\x9b\xdd\x39\x75\xde\x10\x6c\x8e\x14\xb8\x91\x3d\x19\x5d\xce\xf7\x66\xfa\xf7\x6e\x3a\x50\x24\x93\xca\x43\xb9\x9b\xab\xbd\xcb\xd0\xe0\x22\x92\x5d\xe2\x44\x92\x41\x27\xce\xf9\x9d\xc3\x99\x5b\xc9\x85\xbc\x03\x41\x40\x25\x08\x24\x15\x9d\xc8\xd2\xae\xf5\x78\xef\x70\xda\xc9\xa8\x30\xef\x94\xc1\xc8\xbb\x17\x91\x77\x00\xc8\x57\x36\xab\xca\xc7\x55\x3d\x53\x5d\xdc\x62\x22\x73\xbb\x3b\xba\x95\x3c\x14\x26\x64\x82\xea\x33\x1a\x48\xd0\xbc\xcc\x6a\x94\x6e\xe5\x55\x93\x98\x5d\xf1\xd1\x7a\xef\x07\x53\x8e\xbb\xcd\xe9\x6d\x64\xe9\x98\x90\xf5\x6e\x92\x6a\xdf\x43\x20\x11\x28\x85\x94\x7b\xe7\xaf\x5b\x17\x1e\x74\x73\x96\x4a\x3c\x24\x92\x08\x24\xac\xcd\x4d\x3c\xdb\x8d\x13\xc2\x49\x24\x92\x49\x24\x98\xaa\xcb\x98\x59\xdd\x60\x10\xc9\x28\x24\x88\x44\x94\x52\x09\x6e\xd6\xb9\x75\xea\xa2\xfa\xc3\x24\x92\x7b\xc2\x8f\x09\x43\xb5\xbb\x1d\x7a\xbd\x2d\xdd\x04\xb0\x14\x90\x6d\x0a\x90\x51\x97\x51\x99\x27\x71\x96\xcd\x83\x30\x26\x14\x00\x14\x78\x09\x01\x10\x1b\xa5\x9d\xce\xc3\x8d\x1c\x03\xcc\x77\x72\x54\x31\x83\xba\x38\x17\x26\xbb\x79\x0b\xc7\xd7\xc1\xd2\x05\x8a\x26\x48\x70\xd4\x43\xab\xe8\x6f\x91\x04\x90\x46\x8c\x0c\xf6\x34\x42\x76\x6a\x7b\x97\x16\xaf\xc8\x4b\xca\x0a\x37\x69\x54\xe4\xc5\xa7\xcd\xdc\xc1\x94\x26\x8c\x26\x7b\x6e\x4a\x9b\x99\xbe\x90\x54\xcd\xc3\x55\x0f\xa0\xd1\x31\x05\x68\x32\xc9\xb7\x18\xd9\x4d\x64\xe1\xad\xdc\x9d\x8b\x82\x6a\x1e\xc3\xdc\x71\xae\xe6\xad\x5c\x79\xa4\x15\x21\xc3\x02\x0a\x90\xa5\x14\xa7\x0a\x03\xb0\x71\xb0\x8e\xee\xaf\x95\xab\xac\xc8\xb9\x7e\x2f\x00\x2a\x09\x20\x92\x49\x20\xe0\x31\x76\xb7\x76\xd6\xd1\x59\x51\x88\x02\xb9\x24\x12\x49\x27\x87\xbd\x76\xda\x87\x9d\x3d\xe7\x68\xf3\x91\x7d\xd7\xb2\xaf\x30\x53\x60\x54\x90\x23\x29\xb8\x0d\x9d\xcd\x08\x1e\x71\x05\x5a\x37\x63\x37\xcc\x8d\xf2\x3a\x09\x24\x92\x78\x23\x99\xaf\x61\xf7\x0e\x6b\xf3\x94\x20\x92\x29\x20\x2b\x1b\xb7\x36\xa3\xbb\xa6\x87\x24\x81\x79\x7b\xa2\x69\x58\xdf\x1f\x97\xce\x69\x14\x06\xe6\xd7\x77\xb3\x7b\x9b\xe0\x8e\x01\x91\xdd\x17\xa1\x4b\xab\xe7\x38\x2b\xcf\x0e\xf8\x0b\xa9\xcc\x4e\x32\x75\x37\xb7\xaa\x24\x44\x03\x61\x5d\xd2\xd1\x37\x59\x8e\xe0\x54\x56\x3c\xdb\xeb\xda\xa4\xd4\xe6\xb8\xee\xc4\xc2\x3d\x45\x42\xab\xdd\x8b\x75\x1b\x09\x6d\x54\x55\xa6\xe5\xe6\xd5\x6e\x91\x73\x34\x2c\x53\x4d\xb3\x8a\xb6\x43\x24\x48\xce\x1f\x14\xe2\x0e\x35\x25\xcb\x46\xc6\x56\x8c\x1a\x6b\xc7\x3b\xe7\x3c\x71\xc7\x5d\xf9\xb0\x04\x00\x93\x17\xe7\x5c\x4b\x0d\xb6\x49\x24\x02\x41\x26\xaf\xbd\x79\xaa\xb6\x35\x8b\x90\x02\x93\x5b\xd8\xba\x3a\xdd\x6c\xc5\x62\x64\x4a\x12\x41\x04\x93\xac\xcc\x1b\xcb\x9d\xec\xb4\x5a\x40\x92\x49\x20\xf8\x92\x48\x95\xdd\xf3\xa7\x6a\x1e\xf5\x3b\x94\x49\x00\x16\xe7\xce\xb8\x6f\x5c\x28\xec\xf2\x08\x04\x92\x49\xef\x5b\x6d\xb7\x0c\x4c\x23\xc0\x0b\x6d\xbc\x65\xe8\x4f\x61\x12\x49\x24\x12\x48\x24\xb8\xc6\xa9\xee\xee\xaf\x2e\x48\x24\xe2\x44\x92\x45\x89\x7a\x31\x78\x7c\x33\x2a\x73\x18\xed\x58\x59\x46\x70\x9a\xab\x57\x35\x51\xe0\x34\x64\xdd\xe9\xab\x71\x98\x4d\xed\xaa\xbc\x89\x81\xe6\x57\x47\x6f\x31\xab\x42\x6b\x2b\xab\x27\x2e\x3b\x30\x8a\x46\xe2\x95\xe6\xad\xb9\x70\xe2\xeb\x1d\x3d\xc3\x53\x8e\xe8\xe5\xd8\xd8\xc9\xa1\xb9\x42\xf8\xcc\xe6\xf5\xdd\xe3\xae\xfb\xee\xb7\xc8\x45\x86\xda\xaa\x8a\xa0\xa4\x2b\x67\x52\x72\x34\xc6\x0a\x25\x90\x00\xa9\x01\xa3\x96\xe6\x9d\xf8\xe7\x9e\xbc\xf1\xd7\x8d\x5e\x49\x24\x92\x49\x03\x77\x4d\x5e\x6c\x6e\xc1\x44\x92\x49\x27\x83\x5e\xe5\xe5\x3d\xb9\xd1\x72\x89\x24\xf0\xe6\x3f\x2d\xee\xb5\xaf\xc0\x29\x12\x49\x3c\x27\x84\xf6\x6f\x07\x7a\xe7\xa9\xb3\x08\x2b\x20\x22\x41\x07\x78\xfc\x58\x73\x6f\x62\xa7\x7b\x7e\x0e\x08\xe2\x25\xa1\x66\x0f\x24\x3e\xca\x46\xb7\x3b\x2c\x55\x66\x8e\x73\x9c\x03\x1f\x09\x85\xc2\x92\x54\x97\x1a\xd4\x5e\x75\x79\x62\x33\x57\x7b\x55\x20\x92\x4b\xa9\x05\x59\x15\x58\x2a\x92\x05\x93\x73\x33\x37\xde\xdd\xf7\xba\x91\x24\x92\x49\x27\x42\x49\x22\x4f\x4f\x6a\xbb\xad\xe9\xdc\x2c\x92\x41\x24\x92\x49\x24\x9c\x47\x18\x0b\x55\xba\x54\x52\xde\x9a\x1b\x20\x2a\xe9\x9d\x86\x16\xdb\xbc\xd3\xc9\x17\x70\x62\xf2\xf6\x36\x66\xae\x3c\xe8\x43\xb3\x78\x35\x9a\xec\x62\xbe\xd9\xd2\xf5\x6c\xe5\x55\xe5\xc6\x55\x66\x4e\x6d\x0b\x83\xb9\xa6\x98\x92\x4b\x1a\x72\xf0\xb8\x75\x58\x75\xd6\xb9\x7b\xd7\x1d\x75\xd5\xdd\xbd\xf5\xdc\xf2\x11\x41\x40\x59\x15\x45\x8a\x91\x90\xa4\xac\x45\xe0\xe0\xa7\x1c\x2c\x55\x14\x05\x83\x62\xb4\x56\x34\x94\x17\x39\xcd\x21\x94\xb3\xbc\xd6\x9b\x6c\xf3\x62\x65\xb1\x89\x25\x95\x49\x20\x92\x09\x8d\x66\x54\xb4\xd0\xbb\x64\x16\x48\xef\x67\x4e\xdc\xbd\x74\x58\x16\x49\x24\x92\x71\x22\x49\x3e\x0e\xbe\xde\xae\xf9\x5d\xf0\xde\x61\x28\x49\x24\x14\x38\x84\x64\xdd\xe2\xd5\x0a\xd3\x59\xa7\x40\x1c\xd3\x36\xf7\xb2\xb5\xba\xc6\x24\x8e\x69\x85\xde\xc4\x3d\x4f\xa7\x67\xb3\xdd\xa0\x41\x04\x92\x49\x24\x83\x9c\x69\x56\x9d\xbd\xa6\xf4\x50\x24\x93\x69\x14\x45\x02\x29\xd4\xb9\x69\xe6\xde\x39\x1c\x44\xb2\xeb\x59\x3e\x5b\xef\x73\xbd\x24\x92\x49\x24\x92\x49\x25\x49\x2c\x5a\x8b\x8c\x65\xd6\x4a\xa8\xe4\x76\x42\x98\x75\x50\x66\xb2\x2e\x6b\x60\x19\x98\xee\x0d\xee\x6a\x17\xac\xda\xab\x4a\x8f\x77\x6a\x66\xf7\x6f\x71\x65\xda\xcd\x18\x62\x7b\x47\x76\x5d\x45\x22\x91\x0e\x4c\xab\x8d\x99\x9c\xc5\x5b\x35\x73\x73\x58\xb6\x6e\x73\x19\xce\xba\x54\xcc\x61\x08\x40\x64\x64\x01\x0b\x15\x82\xf2\x73\xbb\xbf\x77\x8f\x8f\x6f\x2b\xcd\x78\x30\x01\x77\xe9\xd3\x9d\xe7\x79\xb9\xcb\xbb\x20\x92\x49\x24\x90\x49\xc0\x52\xd5\x4d\xe6\xd5\xd8\xc6\x5a\xae\x4d\x66\x2e\xc9\x24\x92\x49\x24\x92\x49\xcd\x9b\x9c\xcd\x3e\x9e\xaf\x1f\x79\x82\x89\x24\x92\x49\x24\x92\x5d\x15\x73\x15\x72\x71\xf1\xf7\x76\x72\x77\x79\x60\x92\x09\x20\x92\x49\x24\x9a\x77\x10\x77\x5b\xc2\xd8\xc2\x49\x04\x92\x49\x26\xd2\x27\xc0\xf3\xb4\xa3\xb1\x89\x8b\xf2\xef\x36\xa0\x82\x49\x20\xd2\xaa\xaa\x92\x2d\xa4\xad\xea\xaf\x73\x51\x78\x99\xa0\x4e\x14\x52\x49\x24\x90\xb8\x21\x77\x98\xa6\x70\x6d\x41\x28\x49\x24\x10\x66\x49\x9d\x07\xd8\xdc\xdd\x0a\x82\x40\x3c\x20\x79\xc6\x45\x12\x0b\x55\x69\xef\x55\xed\xe7\x68\xdd\x45\x65\x45\x5d\xa9\xbb\x47\x25\x54\x41\x49\xba\x7d\x89\xd5\xc4\x0e\xed\xf6\x0f\x7b\xbc\x3a\xa6\xef\x73\x2e\xf0\xac\x85\x73\xbb\xdb\xb9\x85\x33\x18\xd1\x94\xae\x1d\xb5\x56\x6e\x0c\xe4\xe3\x98\xa9\xd1\xb1\x22\xd9\xd6\xf9\xb3\xa0\x6a\x08\x12\x28\xf6\x92\x03\xa8\x92\x0d\xa0\x04\xcd\x08\x08\xc5\x00\x4b\x33\x64\xd5\x44\x8d\xe6\x07\xd6\x5c\x20\x0c\x4a\xdd\x92\xda\xb8\x5b\xb6\xbd\x15\xc7\x04\x90\x41\x24\x03\x24\x36\x4a\x6d\xde\x3e\xd4\x60\x24\x12\x4f\x09\x0f\x0b\x8b\x79\xb5\x90\xf1\x9c\x48\x13\xc2\xed\xad\xa9\x6a\x22\x3c\xef\x6d\x82\x09\x04\x92\x77\x1a\xd7\x7a\x0a\xb6\xc9\x90\xc8\x24\x90\x40\xf0\x40\x2e\xfa\xb7\xbb\xd8\xb7\xdb\x3d\xb9\x18\x41\x20\x93\xc5\xb3\x8b\x6a\xf6\xf7\x6d\xc6\x0c\x24\x90\x41\x20\x90\x77\x0d\xc8\xcd\x73\x8e\xf8\x81\x24\x82\x49\x23\x04\xc4\x6d\xa9\x8b\xc7\x19\x60\x82\x4f\x09\x24\x9f\xad\xfd\x2f\x67\xa2\xaf\x4e\x86\xcc\xd9\x8c\xa6\x52\x4a\x31\x59\xa6\xa9\x66\xda\x14\xd4\xd0\x62\x69\x23\x6a\x31\x46\xd4\xdb\x68\xda\xd2\xc2\xc6\x26\xde\xf3\x92\xdf\x5d\xae\x35\xb1\x6a\x2d\x45\x6d\x17\x17\x15\x32\x86\x63\x56\x2b\x6d\xa6\x62\xd8\xa2\xa0\xac\xc3\xfb\x7b\x75\xf3\xac\x3e\x3e\xb6\xef\x6e\xdc\xc6\xa9\x16\x26\xc0\xbe\x37\x36\x52\x18\x95\x51\x5a\x36\x19\x30\xa2\xa5\x35\x42\x52\x91\x56\x89\x4d\x6c\x46\x34\x69\x24\x92\xc6\xb1\xb2\x6c\x65\x12\x94\x66\x31\x57\x7b\x00\x2a\x33\x00\xc5\xad\xc5\xb2\xda\x48\xf7\x5b\xb5\x5f\x26\xf7\x75\xde\x4b\x0c\xc7\x86\xe6\xab\x73\x35\x10\xd1\x19\x9a\xd8\xd9\x94\x5b\x26\xcc\xda\xc4\x95\x26\x99\xb6\xc4\x4d\xf6\xef\x83\xe9\x00\x00\x00\x92\x07\x4b\xac\x88\x6d\xdd\xab\x8d\xa8\x94\x4a\x31\xb0\x82\x62\x68\x45\x5b\x1a\xc5\x34\xf2\xb6\xe4\x07\xe1\x72\x95\xa5\x19\x36\x90\xac\x94\x6c\x2c\x99\x33\x4a\x43\x36\xac\x36\x63\x59\x3b\xf2\xe4\x6d\x19\xa9\x46\xa0\xad\x1b\x51\xa9\x41\x43\x36\xa6\x84\xc2\x99\x0b\xca\xcc\x7e\x6f\x27\xb3\xae\xbe\x9b\x2e\xd3\x21\x4d\x00\x92\x1b\x40\xb5\xe1\xce\x32\x29\x56\x68\x8c\xcd\xf3\xdb\x9c\xda\xf8\x65\xa2\x30\xc8\xc6\x86\x18\xd9\x57\x3f\x8d\x5e\xae\xb6\xae\x96\x55\x64\xa6\x45\xa4\x69\x6b\x57\xd6\xac\xb5\x70\x52\x26\xd9\xbf\x3a\xb9\x34\xc4\x98\x50\xc8\x9a\x35\x99\x4d\x69\x56\x54\x5b\x1b\x16\xda\x2b\x4d\x54\x58\x61\xb4\x7a\x2d\xef\xf8\x5b\xd5\xe9\xed\x5d\xaa\xc1\x1a\x36\x8c\xde\xa6\xdb\x6d\xab\x97\x8f\x36\xe7\x2b\x2e\x19\x1b\x1a\xca\x26\xd9\xa6\x34\xe7\x37\x33\x48\x2a\x9b\x44\x9b\xc5\xb9\x32\x75\x5b\x7a\x3a\x72\x32\x10\x5a\x45\x12\x93\xbd\x97\x1a\xd8\xda\x36\xa5\x0d\x64\x6c\x43\x5b\xa7\x39\xb5\x8a\xc6\xa9\x93\x4b\x5a\x2a\xd4\xa9\x54\x28\xa5\x29\x16\x8d\xa3\xdd\xf6\x39\x6e\x94\x99\xab\x1d\x57\x29\x46\x94\x98\x9a\x8d\x64\x05\x24\x34\x6d\xa8\x86\x41\x34\x06\xc8\xd6\x2c\xa6\xb6\x62\x83\x7a\xf9\xad\xc6\x4a\x66\x52\xd4\xa2\x26\xf8\x39\x71\xa5\x26\xca\xc1\x84\x9b\x4c\x58\xb5\x7c\xaa\xd7\x16\xb2\x6a\x35\x55\xc5\x6e\x24\xd4\x93\x02\xc5\x36\xf2\x38\xc9\x34\x29\x32\x4b\x2a\x4d\x7d\x0a\xdb\x93\x4d\x3a\x66\xdc\x46\xd3\x65\xb2\x59\x4c\x69\x32\x4a\x4c\x84\xd2\xbd\x6d\xca\xd2\x9a\xd2\xbf\xad\x5f\x72\x6a\x57\x3a\x65\x28\x49\x2c\x9b\x52\x9c\x55\x71\x45\x79\x1a\xae\x7d\x4f\x86\xbe\x2f\x57\xd9\xec\x96\x0f\xd4\x03\x0f\xe5\x00\xd6\xab\xcc\x92\x83\xed\xb6\xfd\xea\x1e\xf5\x76\xc4\x43\x12\xa1\xe6\x41\x73\xc9\x85\x3f\x5f\x92\x5b\x17\x3e\x06\x9c\x31\x92\x07\xe3\xb9\x52\x2c\x24\xe1\xf7\x5a\xc3\xfa\x63\x20\xf0\x6d\x41\xf2\x24\xa4\x3f\xe2\x20\x3e\x5f\xd7\xc1\x1c\xd1\x29\xf0\x10\xbf\xdb\xa7\x17\x2d\x40\x76\x4c\x6d\x61\x31\xf1\x79\x2b\xd9\xcb\xf9\x3c\x0c\x6e\x1b\x37\xba\x1f\xe3\xbe\x99\xc1\x89\x42\x78\xbb\xf5\xdd\xcf\xa5\xa1\x26\x71\x8c\x62\xee\x34\x66\xf7\x33\x9b\xa5\xad\x25\xe5\xe6\x6a\xf7\xbf\xeb\x86\x25\xda\x85\x41\x8e\x64\xf9\x20\x55\xd4\xf4\x43\x1f\xc1\x03\xfc\x5f\x2c\xa5\xe6\x65\x71\x3f\x08\x67\x4f\xdc\x31\x03\xea\x70\x7b\xf9\x80\x7f\xc8\x62\x1f\xf5\x91\x9c\xd9\xae\xd3\xb0\xfd\x2f\x4d\xa5\x2f\x50\x86\xef\x71\xa0\xde\x62\x75\x10\x3e\x68\xfc\x19\x6c\xfa\x45\x84\x8c\x20\x48\xb0\x21\x3d\x62\x01\x3f\x0f\xd6\xda\xa9\xe2\xdc\xf9\xfd\x36\x0f\x81\xc9\x42\x78\xa0\xf4\x4d\x03\xea\x87\xa8\x62\xc1\x74\x60\x35\x00\xed\xef\x50\xca\xc1\x96\xc8\x60\x40\x90\x24\x49\x02\x29\x85\x25\x5b\x8d\xdd\xbd\x7f\x96\x20\xdc\x0f\x8f\x5f\xfd\xe4\x8c\xda\x71\x78\xd6\xfd\x5d\x4d\xbe\xf1\x3b\x81\xaa\x08\x43\xe7\x11\xf9\xa8\x66\x9e\xa8\x1e\xfe\x3d\x87\x2d\xa0\x15\x61\x4c\x7a\x92\x40\x92\x42\x6e\xb3\x65\x4f\xb7\x33\x8f\xa7\xb6\x5e\x36\xab\x17\x2a\x6f\xab\x3e\x94\x12\x01\xd9\x06\xa1\x83\xc8\x1e\x35\xcc\xed\x02\x15\x11\xc1\x0a\x4f\x8e\x3c\x0f\x18\x5f\x0e\x03\xa6\xbf\x10\xa8\x21\x1e\xe0\x34\x40\x98\xbe\x91\x32\x84\xdf\xc6\xd7\x00\x2d\x05\x31\xe8\x27\x12\xfe\x07\xc2\x4c\xdf\xb1\x2f\xeb\xdf\x57\x93\xf1\x36\x22\x8f\x76\x1e\x7a\x1a\x69\x51\x18\xa8\xb6\xd5\x28\xfa\x35\xad\x70\x06\x1b\xef\x3f\x08\x1b\x77\x9b\xd6\xef\xd9\xcb\x48\x5e\x7c\xe2\x04\x21\xcd\xb7\x44\x11\xf4\xb9\x89\x3c\x57\xe8\x3d\x4f\x00\x5d\xa9\xc0\x79\xa9\x12\x1f\x27\xdf\xb8\xd4\xf0\x7d\x0a\x73\x38\x52\x35\x2a\xb5\xf4\xdf\xb3\xbc\x7f\xce\xa9\xfb\x12\x79\x6a\x8d\x91\x2d\x64\x3e\xe1\xfc\x10\x5b\xbe\x55\x72\xda\x1a\x05\xda\xff\x1e\x26\xc3\xed\x25\xd2\x44\x32\x09\xaa\x94\x11\x80\x9b\xfc\xee\xa1\xb1\x5c\x93\x11\xc7\xfe\x57\x31\x40\xf8\x0f\x78\x21\x29\xf8\xf7\x4b\xd8\xfc\xf1\x36\x11\xcd\xf4\x3f\x71\xf4\x1e\x49\x38\x14\x9a\xbd\xe5\x4d\x1a\x93\x20\x20\x50\xd2\x88\x4b\x21\xa6\x0c\x48\x20\xe6\xa9\x98\xdd\x46\xe9\x0c\x49\x27\xe2\x4f\xc0\xb3\x0d\xbf\x3f\xd3\x74\x3c\xc2\x92\xdb\x5a\x6d\xc6\x9e\x64\x0c\x38\x62\x41\x88\xa5\x26\xc9\x3e\x49\x0f\x64\x34\x87\xc6\xcd\xf5\x0a\x3d\x85\x94\x30\x70\x81\xca\x57\x30\xcd\x2d\x03\x30\x62\xe5\xd2\xd5\xfe\x92\xfa\xc5\x2f\x26\x8b\x20\x7a\x67\x32\x64\x24\x42\x3c\x78\xa5\x5a\x25\x01\xa4\x71\x25\x94\xb8\x65\x29\x55\x44\x68\x56\x2c\x05\x31\xb1\x40\x47\x20\x01\x10\xe6\x08\xcc\x61\x0a\xea\x48\xdf\xa3\x32\x2a\x92\x25\x20\xe4\x06\x39\x33\x25\x71\x88\xd6\xd7\x3a\xd6\x26\x16\x37\x56\x09\x23\x0e\x30\xc6\x0a\xb0\x66\x9d\xb8\xe5\xbb\xb8\x0f\x80\x23\xa9\xa8\x6b\x32\x12\x5a\x59\x23\x41\x8e\x52\x5c\xa8\x27\x03\xaa\x9c\xf3\x99\x74\x1b\xe2\xdd\x30\x18\x05\xb8\x5e\xe8\x1a\x53\x71\x91\xa3\x14\x3d\xbe\x64\x0e\x23\xe7\x9c\x8c\x8b\x20\x12\x0c\x8b\x23\x22\xf5\xc5\x2c\x21\xb4\xa8\x5d\xc8\x70\x85\xb3\x25\x58\x78\x66\x55\x21\x33\xe0\xd2\x17\x43\x3f\x45\xb3\x9f\x2e\xc3\x9e\xd5\x6b\x19\x00\x6a\x97\xb0\x68\xe0\x44\x02\xce\xc5\xec\x08\x6b\xc9\x4f\x43\x07\x02\x96\x8a\x21\xe1\xb5\x02\x40\x26\x44\x60\x34\x4a\x49\x03\x26\x64\x57\x04\xc7\xc7\xdc\xb0\x0b\xba\xaf\xbf\x62\xc8\xbd\xd3\x94\x18\x9f\x2b\x7b\xe7\x31\x03\x9c\xd6\xeb\x91\xa6\x12\xd4\x99\x32\xd2\x05\x92\x12\x3d\x99\x86\x71\x6e\x71\x46\xbc\x4c\x13\x49\xad\x68\xb5\xe1\x0f\x88\x3c\x52\x06\xb3\x59\xac\x3a\xc5\x6a\x00\xb9\x26\xbc\x7a\x6f\x4a\x60\xc9\x33\x2d\x44\x2b\x43\x0b\xa5\xae\xd8\xa2\x1a\xba\x3a\xd6\xb4\xd9\x27\x8a\x79\x18\x4c\x69\x68\x9a\xb0\xd4\x39\x9d\x1b\x13\xa8\x19\x70\x2e\x6c\xcc\x78\xd4\x9e\xb3\x6e\xb5\x0c\x33\x2d\xa5\xef\x49\xad\x0c\xc5\x60\x09\x03\x7b\x2c\x13\x2e\xb0\xda\x64\x33\x6c\xcc\x9c\x92\xbc\x53\xf9\x6e\x88\x75\x43\x52\xcc\x3b\x73\xb3\x62\x72\x76\x6f\xb3\xa3\x93\x35\x9c\xba\x2a\xaa\x2b\x42\xa2\x11\x00\x5b\x4a\xdd\x64\x84\x51\x98\xcd\x06\x6e\x66\x5f\x1a\xbe\x73\x1a\xce\x0b\x2a\x00\x62\xa9\x59\x68\x64\xb0\x9b\x2f\xbd\x63\x79\x71\x10\x00\xd8\x61\xb6\xe3\xa7\x9f\x1b\x80\xe9\x69\xcb\xdb\xc1\x89\xde\x05\x70\x69\x68\xa5\x0e\xd5\x86\x70\xd3\x16\x78\xab\x83\xdc\xc8\x1a\x79\xa2\x31\x40\x58\x60\x2a\x33\x7b\xb0\x38\x03\x31\xbf\x8f\x83\xdd\x03\xd7\xe5\x74\x3d\x80\x1f\x6a\x38\x19\x35\xf9\x50\xfd\x3f\x63\xb0\x0d\x0e\x1b\x35\x33\xe9\x48\xe8\x06\xef\xba\x90\x25\xd3\x0b\x1f\x2e\x0f\x11\x08\xa7\xed\xfe\x6a\x1c\xa2\x5c\x0b\x60\x19\x1b\x6f\xd0\xee\x84\x23\x3b\xbf\x36\x0e\xc9\x6d\x94\x3c\xe0\x57\x7d\x36\xbd\x05\xeb\x3b\x3a\x17\x0a\x73\xf6\x3c\xf6\x9d\x3f\x7a\x9b\xbb\x7f\x08\x40\x32\xe8\x14\x65\xcb\xa7\x05\x4e\xcb\xc5\xec\x51\x6d\x6b\x83\x93\x7e\xfd\xe7\x63\x4d\xe5\x62\x03\xf9\x8b\xe8\x60\x39\x6e\xc5\x3d\x8e\xe5\x4a\x40\xea\x93\x06\xf6\xc5\xd8\x28\x6b\x73\xb8\x6e\x30\xc9\xf5\x20\x26\xde\x20\x97\x9b\x88\x56\xd6\x5a\x77\x8f\x5d\x11\xf2\x34\x13\x7f\x48\x81\xe1\xdf\x7d\x3b\x0e\x21\xc2\x94\x2f\xe2\xb3\xd9\xe5\xa8\x6c\x81\x23\xfb\x50\x27\x67\x7d\xed\x3f\x9a\x07\x3f\x0d\x8b\xe4\x0f\x61\xe0\xc7\x87\x5a\xb3\x21\x54\x55\xad\x62\xc4\x23\x23\x00\xf2\x40\xfd\xe6\xd3\x27\x7b\xb9\x52\x90\xcc\xcb\x31\x91\x85\x55\x11\xf3\xf0\x00\x82\x9d\x7b\x03\xaa\x8a\x97\x46\xc1\x14\xea\x1c\x03\x97\xc7\x7e\xbb\xdd\x9e\x7e\x02\x7b\x8b\x2a\x80\x35\x33\xd7\x67\x1f\x29\x7f\x0a\x3d\x61\x79\x86\xee\xdf\x87\x98\xd0\x78\x64\x98\xeb\xb6\xb8\xd8\x3b\xa9\x67\x15\xca\xf6\xb9\x68\x0c\xbf\x4e\xee\x22\x3a\xdf\x86\x42\x76\x91\xd9\x06\xb1\x52\xc3\xcb\x20\x73\xa3\xba\x0f\xca\x37\xc0\x67\x81\xe2\xe4\xce\x85\x71\xc8\x5b\x52\x41\xab\xce\xcb\x14\xf9\xf3\xde\xf2\xd4\xae\x3d\x91\xea\xa0\x7a\xb7\xc3\x7c\x07\xd5\xaa\xa0\x14\x0f\x3d\x71\xc6\x7b\xce\xf3\xe7\xe5\x14\x92\x56\xdb\x7d\xf1\x55\x56\x28\xa2\x8a\x3e\xb2\xe0\xf5\x46\xd5\xb6\xdb\x4b\x69\x6d\x2b\x89\x9b\xb2\xaa\x28\xa2\x8b\x1d\x52\xf5\xb7\x6a\xb7\x2d\x30\xbd\x9f\x1c\xbc\x56\x8e\xe0\x5d\xc5\x78\xdd\xb3\xb1\x52\x66\x75\xcc\x3e\x80\x23\xc4\x3b\x00\x7b\x2f\x02\x57\x60\x40\x1b\xce\xec\x0c\xc9\xed\xca\xc1\x30\xec\x25\x09\x24\x8c\xc5\x09\xac\x38\x76\xde\x28\x0d\x00\x3b\x97\x05\x06\x77\x86\xa0\xd5\xc6\x15\x72\x95\x42\xb7\x04\x72\x5f\x45\x3c\x38\x41\x46\xc3\x52\x04\xef\x2e\x9e\xdf\xbb\x2b\x6d\xb9\x60\xf0\xd9\x0f\xd9\x63\x9b\xdb\xb1\x42\xcb\x00\xae\xe5\x17\x65\xdf\xed\x88\x03\xe4\xa9\xee\x76\x03\xc2\x0e\x9c\x8e\xea\xbe\x55\xd9\xbb\xaf\x4c\x3b\x00\xd6\x1b\xc8\xe7\x0d\x8e\xe4\x7a\x64\x86\x10\x90\x08\x67\xb0\x2c\x77\xc4\xdd\x8d\x61\x39\xf5\xbe\x58\xd1\x8e\xfc\xb9\x5a\xc4\x31\x5a\x91\xae\x8a\xab\xfb\xb4\x39\x36\xe6\xb7\xf7\x80\xf7\xd8\xe1\x05\x4b\x28\xc7\x42\xc8\x11\xdf\x47\x69\xc4\x9f\x44\xaf\x40\x13\x71\xb1\x8c\x3b\xfb\xbb\x9e\x77\xc3\x1a\x77\x13\xc2\xea\x5a\x1a\x19\x7e\xf0\x72\x21\xe1\x88\x18\x0e\x7e\x3b\x7b\x03\xb4\xba\x14\xf9\x17\x12\x42\x7c\xe7\xd8\x7f\x9a\x10\x43\xf4\x6c\x30\x03\x52\x94\xc8\x7d\xbc\x4f\xc2\x91\x6e\x24\x63\x6d\x7b\x79\xb9\x68\xdf\x12\xb7\x21\xac\x2d\x69\x44\xd5\xa8\x23\x14\x09\x17\xab\x48\xb0\x58\x20\x0c\x0d\x24\x27\x3e\xa9\xaa\xc0\x05\x21\x12\x1c\x49\xa5\xd5\xc2\x0a\x02\x2d\x92\x0c\xb3\xf3\xcb\x46\x36\xaa\x51\xc6\x60\xc8\x45\x8a\x99\x70\xa2\xfa\xcc\xd9\x36\x3a\x32\xe3\x54\x98\xc9\x0b\xa6\x3d\x40\x32\x3f\xc3\x6e\x9a\x5f\x4b\x69\x45\x95\x33\x01\xe7\xa8\x85\xbc\xcd\xe8\x72\xa0\xa1\x82\x40\x8d\x20\xe8\x8f\x31\xfb\x23\x74\x80\x5f\x1f\x0b\x59\xb8\xfd\x22\x86\xc0\x8a\x78\xae\x07\x7f\xc4\x80\x68\x84\x80\x48\x81\x11\x62\x41\x82\xc0\x24\x90\x8c\xcf\x1d\xf9\xe9\xb3\xd0\xc0\xb8\x7e\x07\x3a\xf6\xee\x01\x20\xfd\x4d\x9b\x5d\x8f\x0e\x44\x20\xb2\x43\x8f\xd7\xda\xeb\xb0\x2f\x46\x10\xb4\x91\x92\x40\x90\xf5\x7b\x80\xfa\xbf\x47\xc4\x34\x6f\xdd\x37\xa6\x7a\x35\x30\x7a\x58\x97\x2d\x62\xa9\xa2\xae\x35\x60\xf7\x1a\xbb\x8b\xe9\xca\x36\xbd\x5c\xac\x06\x6a\xe0\x6a\x68\xc9\x36\x54\x12\xd6\x1a\x08\x09\x96\xcf\x3f\xaa\xd8\xd9\x0c\xe4\x4d\x02\x75\x17\x5c\x0a\x56\x82\x9a\x17\x7e\x52\x92\xd7\x0a\x45\x90\x59\x68\x1e\x98\xb0\x7f\x28\x10\xc7\xc4\x07\x21\xe3\xdb\xc3\xb8\xfa\xf6\x2b\x19\x22\x90\x92\x1d\x15\x3c\x43\x03\x7c\x92\x42\x0c\x89\x0e\xf9\xcd\x1d\x09\xce\x76\x36\x8c\x96\xd1\xc8\x2e\x7d\x91\x10\xa1\x47\xb8\x6b\xd3\xf0\xb3\x7a\x90\x92\x34\x18\x3c\x13\xc3\x03\x1e\x47\x3c\xf7\xb8\x92\x3b\x06\x24\x08\x81\x12\x4a\x8d\x55\x21\x1a\x44\xbf\xa0\xf8\x76\xe3\x20\xfc\x8d\x85\x83\xf3\xa6\xf0\xc0\xdf\xe5\xb3\xc3\x4e\xf3\x2d\xc6\x88\x56\x67\xf0\xcf\x5e\xff\x1c\x7b\xcf\x0e\x50\x66\x1e\xaa\x2e\xc6\xc6\x92\x01\x19\x7d\xe9\xa0\x70\xed\x9b\x60\x67\xaf\xeb\xab\x5a\xd8\xda\x05\xdf\x63\x80\xf9\x6b\x5b\x9a\x8f\xe6\xe3\xac\xce\x37\x88\x9c\x26\x2b\x06\xb9\x38\x00\xe1\x02\x29\x14\x0b\xa9\xde\x6b\x36\x1c\x43\xef\xd6\x63\xba\x71\x27\xbe\xae\x2d\x96\x0e\xb3\x3d\xf9\x4e\xee\x4a\x9b\x0b\xf8\xc2\x49\x61\x0c\xdf\x12\x28\x0c\x22\xa3\xda\x15\x4a\x01\x6b\xcf\x7d\x9b\x79\x5b\xd1\xe7\xa9\xb1\xa4\x6b\x35\xd0\x45\xae\x23\x58\x59\x8d\x9b\x16\x59\x64\xaa\x54\xd9\x58\x0c\x50\xa9\x55\x48\x07\x98\xa6\x24\x15\x0d\x87\xaa\xd3\x65\x76\xf9\x6d\x03\xd7\xb8\x02\x97\xa6\xbe\xa4\x4d\xd1\x59\x21\x08\x07\x61\xb4\x0b\x11\x00\x79\x74\x7d\xc5\xf1\xc5\x51\x72\x1e\x00\x98\x3c\x87\x9f\x53\xf6\xc1\xee\xf5\xf4\xb2\x54\x1f\x81\xee\xe8\x84\x84\x81\x20\xcf\x64\x36\x7e\xc8\x6f\x72\x27\x76\xff\x1b\xee\xc7\xc8\x65\x90\x2c\x9d\x42\x5a\x16\x84\x3d\x1d\xa4\x52\x1c\xfb\xfe\x0c\x2a\x73\xf0\x7e\x2d\xa5\x8c\x8d\x4b\x21\xb1\x2c\xf1\x2a\x05\x54\xaf\x5c\x44\xc7\x8c\x16\xa3\xb8\xf9\x08\x6b\x09\xc2\x12\x7a\xeb\x66\x5a\xa3\x1b\x07\x32\x40\xf3\xab\xdd\xac\xf9\xa5\x92\xc4\x48\x4a\xcf\x90\xdf\x13\x4b\xd6\x37\x4c\xd2\x42\x5e\x48\xd3\xb4\xa9\xa6\xbb\xcd\x72\x99\x0b\x71\x5c\xcd\x1a\xe2\xc5\x72\xc3\x21\x81\x56\x8a\x0a\x8a\x63\x29\x98\x62\x22\x36\x52\xfd\x3f\x7c\xbd\x90\x24\xa8\xf9\xf6\xfb\x79\x61\xa0\x59\x0f\x20\xde\xa9\xb2\x65\x80\x53\xb6\xd8\x86\x3a\x52\x65\x32\x38\x74\xed\x77\xb6\xbd\x08\x18\xc3\xb3\x82\x05\x3c\xf8\x2e\xde\xea\xe3\x20\x5c\xb5\x86\xad\x41\x27\x43\x83\x36\x9b\x66\x31\x45\x7d\xec\x0e\x26\x0e\x93\x24\x8c\x97\x2b\x33\x12\xf2\x16\xb8\x84\x82\x1f\x50\x1b\x3b\x8a\x67\xc7\x92\x06\x8b\x0d\x3d\x5a\xd3\x11\xbd\xad\x0d\x23\xb2\xb5\x00\x72\x76\x1b\xf5\x2c\x42\x18\x68\x41\xd1\x4b\x06\x3a\x8c\xc1\xb2\xfc\x78\x44\xc5\xb8\x40\x8e\x5b\xca\x29\xe0\x48\x30\x24\x8e\xff\xd6\x58\xa1\xb2\xda\x16\x3a\x20\x4a\xa8\x81\xd3\x57\x29\xa0\xb3\x72\x9f\x54\xfa\x6f\xf3\x06\x6e\xe7\xbd\xd0\x6f\xbf\x3c\x47\x2d\x90\x09\x36\x03\xa4\x28\x86\x6a\x2a\x70\x2c\x86\xf0\x49\xa9\x88\x76\xda\x99\x6a\x32\x43\x5a\xc0\x98\x40\xa6\x24\xe8\x18\x25\xc3\xc2\xbb\x90\xcf\x2f\x11\x07\x3e\xde\x62\x98\xb8\xf8\xa0\x6d\xa3\x18\x00\x7e\x61\xb4\x0d\x41\x30\x6b\x09\x2f\x57\x84\xe6\x46\x48\xe5\x28\x3a\xc2\xd2\xfb\xb7\x74\xaa\x27\x56\xda\x6a\x76\x19\x8c\xdb\xa3\xa4\x47\x89\x9e\x64\xca\x9b\x04\x72\x1e\x69\x48\x47\x62\xe9\x53\x82\xf4\xa4\xc6\x43\x82\xcf\xb8\xe4\x85\xc3\x8b\x14\x73\x04\xb9\x04\xc1\x60\xaa\xa1\xa3\x6f\x1a\x12\xf1\x13\xda\xa8\x64\x57\x58\x81\x68\xc8\xa5\x88\x00\xc6\x0a\xe3\x1a\x41\x8c\x81\xdf\x56\x63\x09\xa2\x03\x09\x58\x70\x2e\x53\x6c\x35\x0d\x34\x2b\x16\x48\x56\x62\x06\xae\x07\xdd\x35\x3b\xd3\x37\xfd\x0e\x4d\x9c\x00\x62\xb2\x44\xd0\x2f\x54\xc1\xce\xfb\x9d\x93\x40\x39\x04\x2f\x05\xa8\x09\x19\x54\x95\x5e\x1d\x6d\xba\xde\x28\xd4\x54\x6c\x69\x9b\x6b\x6c\x6b\x5b\x59\xdc\x64\x24\x7f\x2d\x98\x07\x0c\x80\x9f\x13\x66\xac\xa5\x2d\x0b\x6d\x8d\x96\x5b\x68\xa8\x81\xd4\x80\xc0\xa7\x3a\x2b\xc4\xe2\x07\x9b\xb8\x1b\x83\x22\x41\xa8\xb7\x22\x04\x80\x83\xc8\x2b\x4f\x4a\x9b\xe2\x8b\x9c\x34\x8e\x83\x78\xd6\x57\xf0\xca\x1e\xfc\xc1\xc0\x90\x1e\xa0\x40\x87\x94\xa6\x18\x40\xc9\x7c\x20\x77\x6e\x5b\xa3\x23\x72\xe5\x08\x70\xd0\x97\x08\x87\xa4\xed\xcc\x76\xd5\x77\xd2\x7a\x21\xdc\x6c\x33\xc4\x49\x21\xaa\x0e\xda\x0e\x16\x28\x18\xda\xea\xd8\xb1\x17\x56\x48\x06\xd9\x56\xb8\xab\x6e\x6a\x68\xdb\x45\x16\x8b\x71\x6e\x06\xc2\x50\x85\x1e\x44\xac\x01\x68\x57\x90\xc4\xbc\x11\xd2\xe4\x48\x69\xb1\x2c\xb7\x54\x86\x9b\x49\xa4\x12\x48\xb1\xe7\xee\xed\xb3\x61\x91\x0e\x45\xcd\xfc\x06\x8f\x1b\xbc\x17\x59\xe5\xe6\xf0\x2f\x8a\x7e\xa0\x0f\x2c\x4b\x6a\x27\x4f\xb0\x2d\xb4\xf7\xc1\x43\x41\xf4\x70\xc2\x3a\xb7\xe2\x03\xfa\x1a\x1c\x16\xd5\xa3\x15\x7d\x39\x5b\x6a\xc2\x68\xa9\xae\xbc\xb0\x40\x1e\x07\xcd\xd1\x96\x44\xd9\x24\x02\x4e\x1f\x7e\xc2\xd3\xe4\x63\x85\xdc\xdd\x65\xce\x9c\x2f\xcc\xf9\xa7\x48\x19\x22\x43\xf7\x63\x6c\x2a\x84\x5f\xa4\x50\x6c\x70\x33\x03\x6d\x83\x09\xee\x44\x81\x52\x01\x46\xa0\x11\x89\x0f\xa8\x81\x59\x03\x91\x92\x7b\x8e\xd8\x62\x0b\x41\x2b\x2c\xf8\x50\xde\xa9\xa4\x20\xb5\x1d\xfb\xdb\x4f\x05\xfa\xbe\x76\xbe\xe8\xa0\x48\x3a\x51\x46\x08\x40\x41\x1a\xdd\x60\x2e\xa3\x1e\xb2\xf0\x4c\x7e\x5a\x0d\xf1\xa2\x68\xa0\x65\xc4\xa0\xc1\x06\xbe\xe7\xe0\xc0\x1c\x0d\xf8\x0a\x7d\xab\x69\x26\x51\x30\x78\x5e\x45\x90\x64\x24\x8f\x26\x8a\x06\xc1\x04\x22\x07\x87\xe6\x86\x84\x9a\x48\xb8\xc3\xe6\x93\x2d\x3d\xa7\x0f\xbf\xd1\x43\x6a\xfd\xd2\xdd\x09\xc4\x4d\xc3\xae\x37\xd6\xdf\xd5\x98\xc3\x07\x88\x1b\xb3\x72\x47\x84\x26\xe5\xef\x61\x73\x2c\x05\x3c\xa0\xef\xef\x29\xc4\x31\x2e\x51\x41\xb8\x6b\x9d\xd4\x27\x3a\x89\x63\x9e\x55\xb9\x1c\x7f\x58\x6f\xa3\xa6\x99\x1e\x7a\x0e\xa8\x6b\xda\x6e\xcf\x47\x50\x4e\x4e\xbf\x06\x58\x1e\x86\x6d\x63\xda\x04\xd8\x6b\x79\x0c\x8f\xcc\x25\x2a\x1e\x53\xe9\x41\x49\xce\x11\xa4\x23\x54\xa5\x1f\x50\xa2\xcb\xba\x86\xe5\xdc\x2c\xa1\x68\x21\x50\x00\x87\x00\x07\x7a\xe0\x5c\x65\x5f\x71\x8c\x6f\xf3\x62\xc9\x8b\x81\x47\xd5\xa0\xb2\xa6\x1e\x45\x91\x63\x78\x34\x7c\xdb\xe7\xd2\x01\xcd\xf7\x32\x77\x67\xaf\x75\x7c\xae\x89\x41\x21\x6e\x9a\x0a\x08\xb7\xca\xef\xe7\xf9\x8d\x88\x77\xd2\x8d\x55\x74\xf1\x2d\x27\x6b\x86\x5a\xdc\xb7\x37\xf9\x43\xda\x37\x4c\x08\x83\xfa\x28\xc9\xe3\xda\x49\xb6\xda\x82\xcb\xa1\x35\x18\x40\x90\x3c\x29\xbc\x90\xfc\xff\x53\xa0\xee\x03\x3d\x6c\x3c\x7e\x69\x1e\xb0\x97\x77\x1a\xc5\x54\x64\xa9\xa3\xd7\xce\x74\x96\x8c\x46\x8d\x1a\xb2\x15\x9a\x9d\x3a\x5c\x0c\x8d\x68\xca\x90\x5c\xb4\xc0\x60\x54\x5d\x32\xae\x9a\x4b\x20\x94\xd2\x58\x0b\x96\x98\x0c\xa3\x22\x30\xc8\x26\x87\x51\x80\x8b\x18\xc9\xa9\x04\xa3\x23\x58\x15\x0b\x6c\x28\xcb\x69\x5a\x94\xc1\x67\x28\x2a\x0d\x6d\x63\x6c\x5b\x18\xd7\x5e\xbc\xba\x30\x2e\x38\x68\x8d\xa7\x39\x71\x55\xb8\x4d\xab\x8d\x24\x44\x50\x8c\x19\x15\xfc\x81\x2e\x53\xdd\x1f\xc0\x95\x05\x58\x3b\x3e\x04\xe0\xdc\x49\xf3\x81\x93\x7a\x4f\xaf\x7f\x11\xea\xb8\xfd\x1d\x81\xab\x55\x53\x48\xc5\x08\x77\xe2\x83\x09\xf3\x86\x43\x44\xf6\x05\x92\x25\x8c\xba\x03\x03\x28\x05\x0b\x1b\x29\x0c\x81\x3a\xc6\x53\x12\xa0\xa4\x26\xda\x0c\x34\x24\x24\xc4\x30\x48\xa0\x1b\x61\xa6\x63\x0d\x41\x20\x5d\xd8\xb2\x65\xa8\xcf\xc8\x87\xd7\xf7\x60\x7a\x1b\x30\xf8\xf9\x1a\xe9\xf1\xb6\xfb\xc0\x6c\x9b\xcc\x2d\x60\xc5\x6d\x7c\x77\x1e\x89\x0b\x16\x78\x45\x5a\x60\x01\x08\x90\x42\x20\x6d\x31\x80\x5a\x31\x24\x43\x13\x5f\xaa\xbb\x05\xe0\x1b\x45\x1e\xd6\x83\x96\x76\x86\xb1\x05\xd1\x45\x4d\x4e\xe5\x77\x82\x6b\x0d\x0f\xd5\x26\xf3\xb7\x52\x88\x1a\xc8\x43\xb2\xea\x94\xdb\x27\x0a\xad\x07\x88\x9b\x64\x58\x44\x88\x5d\x00\x62\x35\x07\x7e\xe1\x35\x8e\xcd\xa8\xa1\x48\x58\x85\x18\x07\xa4\x0e\xbd\xe6\x86\xcc\x30\x32\x14\x39\x6f\x40\xe0\x06\xf5\x48\x14\x29\x5c\x54\x3f\x20\x4f\x8e\x89\x35\xb8\x0c\x08\xdb\x56\xdb\x18\xaa\x06\x31\x82\x32\x47\x07\x22\xc9\x40\x95\xdc\xa6\x1c\x84\x81\x3e\x13\xe2\x07\xa4\x08\xaa\x20\x0a\xa2\x8d\x18\xd1\x4d\x2b\x7b\x2d\xbb\x6f\x1b\xc6\xef\x70\x99\x09\x53\x2b\x56\x4b\x4e\x96\x45\xb6\x08\x03\x4b\x71\xc2\xd6\xb9\x65\x05\xb8\x58\x59\x39\x90\xe4\x0c\xf9\xc3\x6c\xa0\xba\xb9\xf2\xb6\xdb\x6d\xa7\xfd\xef\xe9\xad\x6e\xdb\x6d\xb6\xdb\x6d\xb6\xdb\x4b\x6b\x7c\xb7\x2d\xab\xbf\xc7\x5a\xd5\xb7\x64\x43\xbb\x62\xf0\x96\xe1\x74\xc0\x04\xd4\x47\xcf\x75\x2b\x2a\x75\xa4\x03\xed\x58\x15\x62\x43\x9f\x57\x52\x0c\x0c\x05\x6c\xc3\x29\x3e\xa4\x73\x2a\x8e\xbb\x6d\xcf\x68\xd4\x11\xca\xfc\x8c\x0f\x04\x4d\x07\x4a\x7d\x99\xe0\x01\x70\x4f\xa2\xf9\xbd\x8d\xc1\xbd\x9f\x27\x88\x1b\x48\x74\xa5\x10\xa0\x28\x17\x0f\x63\x27\x9f\x3e\xab\x40\x93\xb7\x08\x98\x3a\x93\x5e\x77\x59\x01\xd6\x20\x13\xe7\x21\xc0\xfe\x44\xae\x37\x0e\xee\x8c\x85\x60\xa5\x5d\x2b\x74\x9a\x72\xc9\xba\x91\xca\x04\xe8\x7d\x3b\x14\x88\x24\x4a\xae\xf1\x85\xb8\x60\xfb\xbe\x00\xe8\xf6\x01\x08\x6c\xba\xaa\x20\xc0\x91\x18\x21\xf7\xda\x3c\x16\xcf\x10\x69\xa1\x03\x30\x03\x02\xfa\x38\xda\xaa\xf6\x33\x44\xb4\x8d\x93\xae\x11\x03\x92\x50\xe0\x35\x1d\x06\x0d\x3b\x39\x29\xb6\x05\x25\x75\xc8\x13\x7a\x03\x96\x16\x86\xc1\x69\xb5\x6c\x5e\xa3\x72\x02\x58\x41\xa4\x06\x45\x46\x12\x47\x51\x3c\x9e\x24\xb4\xa2\x16\xcb\x22\xde\x00\xe7\x82\x0e\x4c\x5a\xe2\xb4\x05\xa3\x88\xba\x40\x19\x94\x90\x2d\x50\x7c\x8b\x38\xa1\x6c\x02\x64\x85\x86\xfd\xa1\x64\xc5\x2f\xb8\xe3\x02\xfb\xcb\x9b\x7b\x74\x7d\x75\xad\x61\x96\x63\x90\xc0\xa8\xc1\x09\x92\xa9\xe2\x14\x58\x20\x2d\x69\x41\x8f\xb0\x92\x9a\xba\xb6\x45\x59\x49\x17\x5b\x41\xa0\x25\x15\x19\xc2\x00\xc1\xc8\x50\xe1\x18\x30\x0f\x0f\x8f\xb9\x9a\xca\x8d\x59\x50\x0a\x22\x3b\x88\x18\x83\x26\xb1\x0a\xed\xbb\x6b\x26\x72\x19\xb5\x32\x01\x78\x05\xd6\x60\x99\xbb\x59\x9d\xb4\xbb\x2c\xe2\x22\x3c\x8b\x5d\x97\xe2\x9a\x93\x3a\xf5\x71\x91\xeb\x62\x72\xdb\x60\x95\x00\xa4\x83\x2a\x84\xa1\xf3\xca\x07\x65\x74\xe3\x00\x96\x42\xe9\x03\x9e\x39\xe3\xd4\xe2\x5d\xf3\x9d\x6c\xd6\x0a\x08\x45\x4a\x6e\xcd\x58\x66\x30\x45\x79\x31\x2f\x98\x94\x97\x8a\x54\x1e\x19\xea\xd9\x80\x71\x52\xa8\x82\xbb\xf9\x1d\xfd\xde\xae\xca\x6f\xee\xc1\x15\x59\xab\x34\xec\xd1\x4d\x8e\xb3\x33\x37\xac\x4c\x10\xbd\x8e\x5c\xa6\xf7\x03\x6b\x1a\x40\x1d\x56\x94\x3b\x8e\x09\x12\x07\xb6\x24\x80\x7c\x00\xd6\x47\xbf\x45\x93\xdb\xec\x0a\xb1\x7a\x0b\xe9\x4b\x19\x30\x1c\x81\x6f\x34\x2f\x34\xbc\x22\x79\x43\xac\xa5\x4d\x61\xb4\x0e\x44\x0e\x8b\x0a\x1a\x65\x45\x83\x8c\xb1\x4a\xc5\x2d\xa6\x39\x34\xb2\x92\xa6\xad\x46\x06\x5a\x8b\x04\x8a\x49\x52\x98\xce\xb0\xa9\xc2\x06\xd2\xe7\x10\x53\xd8\x16\x84\x2b\xc3\xe7\x5d\xf9\x9c\xcf\x26\x60\xa1\x83\x06\x4c\x68\x99\x32\xda\xaa\x57\x0c\xb9\x49\xe6\x5e\xe8\x5e\xf5\x28\x82\x97\x1e\xcf\x6d\x9a\x76\xf9\x91\x4c\xb8\x40\x86\x44\xd3\x5b\x02\xc5\xd0\x1b\x2a\xac\x22\x0c\x80\x32\x21\x08\x52\x86\x79\xa7\xa4\x0c\x56\x40\xf7\xf1\x67\x6c\xf8\x3a\x89\xca\x4d\xbc\xda\x47\xe6\x68\xa7\x99\x14\x26\x74\x42\x02\x26\xe8\xea\x22\x86\xf8\x09\x50\x59\x30\x81\x7c\xec\xc2\x05\x1e\xa7\x9c\x49\xfd\xbc\xfe\xd7\xc7\x42\xc1\xc8\x6a\x7b\x54\x83\x10\x01\xa4\xdb\xc0\xaf\x15\x4f\x92\x6c\x0e\x6a\x2a\x64\x60\x86\x35\x7f\x70\x22\xd2\x08\xcd\xdd\xa3\xc0\x1f\xc0\xf7\xbc\x12\x41\x67\x75\xa1\xf9\x79\x8f\xc5\xf0\x18\x5f\xae\x7b\x90\xda\x6f\xd7\xb9\xfc\x54\xdd\xc4\x3a\x1a\x02\xf3\xc3\x8b\x97\x1f\xd3\x37\x10\xf9\xcf\x28\x6a\x81\xd0\x5b\x70\x4b\x82\x78\x00\x1e\x30\x46\x40\x14\xb4\x50\x71\x33\x04\xb9\x83\xe3\xf4\xf6\xba\xee\x47\x20\x3b\x5c\xbb\x48\x7e\x33\x2e\x2f\x53\xbc\x0f\x69\xe8\xb4\xf6\x29\x9f\x47\xd4\xc0\x62\x1e\x49\xa0\x81\xd1\xa7\xc6\x29\x46\x56\x98\xef\x75\xb1\x00\xa2\x9c\x8c\x4c\xa0\x19\x3a\xd2\x12\x05\x0e\x42\x08\x58\x8e\xdb\xfb\x4d\xe3\xd7\x96\x12\xf0\xd6\x1f\xc4\x5a\x9b\x10\xfc\xf6\x21\x71\xa0\xa4\x7d\xbc\x7d\x93\xeb\x09\xd4\x4e\x42\x46\xa3\x8f\x50\xac\x5f\x72\xc5\x88\x91\xd7\x9b\x9f\x7f\x5b\xb7\x37\x9a\xbb\x73\x5e\x28\x1a\x12\x32\x60\x76\xd7\x73\x66\x06\xd1\x3b\x29\xd2\xcd\xd7\x1a\x31\x63\xcb\x60\x80\xa8\x2f\x3a\xe6\xb1\x88\x56\x28\xba\x06\xe1\x58\xb5\x14\x4f\x72\x27\xc1\x5e\xc8\xde\x08\x48\xbb\x7e\x0c\x47\xdf\x71\x98\xe8\x5d\x4f\x3c\x37\xf5\x7c\x97\x00\x39\x87\xa1\x02\x44\x84\x49\x02\x4d\xe7\x78\x3c\x20\x87\xf4\x17\xfa\x0b\xe8\x2f\xff\x8b\xb9\x22\x9c\x28\x48\x0a\xeb\x65\x8f\x80\x01\x00\x00\xff\xff\x49\x7a\xe0\xbf\x73\x2c\x00\x00")
+var _dataInvgroupsCsvBz2 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x73\x2c\x8c\xd3\x42\x5a\x68\x39\x31\x41\x59\x26\x53\x59\x15\xd6\xcb\x1f\x00\x58\xc8\xdf\xb1\x40\x12\x58\x86\x7f\xf0\x3f\xff\xff\xf0\x3f\xff\xff\xf0\x00\x20\x01\x00\x10\x00\x60\x42\x9c\x00\x03\x8d\xeb\xd3\x8d\x33\x27\xd3\xbb\x74\x1b\xba\x3b\x91\x6f\x7c\x03\xee\x0f\x3b\x20\x1d\xf6\xfa\x79\xec\x77\x2f\x80\xfa\x09\x00\x01\xdd\x9a\x13\xef\x75\xde\x78\xf0\xe5\x9d\x81\x25\xde\xad\x95\x7b\x3c\x78\x4a\x68\xd0\x48\x0d\x68\x19\x0d\x53\x7c\xee\xa0\xfb\xb8\xe9\x1d\xb0\x70\x00\x00\x00\x00\x0e\x3e\x90\x07\xc9\xab\x14\x08\x7a\xd4\x25\xb8\x01\xf3\x39\x8e\x3d\x2f\xbc\xdc\xa5\x00\xcb\x90\x16\xcb\xb2\x84\xb3\x76\x63\xbb\x58\x73\xb3\x41\x86\xe8\x96\x8e\x04\xe8\xf3\xe5\xbe\xbe\xb6\xe6\x3a\xa4\xa9\x76\xa8\x4f\xbb\x70\x69\xa0\x9a\x62\x93\x4d\x20\xa4\x7a\x7a\x99\x27\xa2\x00\x00\x00\x06\x80\x01\xa9\xe4\x06\xa4\x90\xa7\xa5\x26\xf5\x46\xd2\x64\x0f\x53\x40\x68\xd3\x43\x40\xc8\x00\x34\x12\x69\x22\x08\x49\x3d\x05\x4f\xca\x69\x0f\x28\x01\xa0\xd0\x34\x00\x34\x00\x04\x9e\xaa\x51\x4f\x4d\x20\xa7\x8a\x34\x68\x03\xd4\xf5\x06\x83\x11\xa6\x4d\x01\xa3\xd2\x00\x04\x49\x00\xa6\x92\x36\x95\x4f\xda\x9a\x53\x1a\x3d\x4f\x54\xfd\x43\x4c\x49\xea\x7a\x43\x10\x62\x30\x08\x11\x24\x40\x40\x4c\x9a\x68\x04\xc9\xa0\xa7\xa9\xea\x34\x46\x9a\x03\x40\x06\x8d\x3c\xde\x6e\xb6\xdb\x5a\xd7\xc9\xe7\xdd\x26\x26\xc9\xb5\x28\x01\x66\x58\x44\x31\x8b\x46\x28\xa8\xd6\x8d\x6b\x16\x66\xda\x31\x29\x54\x60\x45\x91\x66\x96\x13\x58\x86\x42\xb4\x5a\x29\x49\x51\xa2\x42\x98\x6a\x54\x54\x8b\x19\x33\x16\x34\xa5\x4a\x95\x8d\x92\x62\xdb\x16\x8a\xc3\x4b\x31\xa6\x4a\x6c\xb0\x68\xd9\x94\xc0\x62\x94\x46\x9a\x26\xb2\xcd\x8b\x45\x99\x66\x84\xc1\x0c\xc0\xd4\xcc\x54\xb2\x64\x52\x2c\x53\x52\x4c\xa8\xd2\x52\x11\x05\x8d\x92\xac\x51\xa2\xb3\x64\x46\xd2\x22\x62\x93\x51\x4b\x46\xd6\x14\xd1\xa3\x45\x8b\x31\x6a\x32\x8b\x46\xb6\x62\x31\x6d\x85\x19\xb6\x89\xb4\x55\x18\xb4\x2d\x12\xc5\x35\x19\x85\x22\x64\x4c\x44\x86\x19\x6c\xd4\xa0\x32\x9a\x0a\x16\x14\x3e\x8e\x9e\xef\x6f\xe2\x88\x89\x71\xc8\x22\x22\x8e\x73\x72\xf9\xb9\x72\x20\xad\x45\x56\xb4\x66\x5c\xb4\xc6\x2a\xcc\x4a\xc5\x0c\xb7\x1b\x52\xc6\x5d\x6b\xf4\x6a\x69\x0c\xd8\x66\x4a\x31\x16\x61\x41\x0f\xd3\xaa\xdf\xc2\xd9\xd7\xf9\xff\x71\xff\x5b\xfb\x04\x44\xff\x6f\xd8\xdc\xe3\xf4\x31\x55\x6e\xf8\x39\xd5\xc9\xfe\x9d\x65\xb6\xff\x8f\xc7\x16\xd2\x2a\xf2\xcc\x41\x65\x4f\x0c\x25\x97\x9b\x51\xfe\x81\x0e\x58\xcc\x10\x47\x73\x14\xf1\xa5\x83\x6a\x1b\x83\x54\xde\x65\x43\xb6\x12\xba\xcd\x04\xd0\x7e\xee\x26\xed\xb0\x73\x44\xce\x37\x2d\x2f\x55\x68\x3c\xd5\xea\xf0\x39\x52\x14\xcc\x21\x28\x43\x46\xfe\xef\xf8\xd7\x44\x7f\xdd\x8d\xef\xfb\x74\xef\xc7\x01\x4a\x90\xa6\x02\xf5\xeb\xd3\xa1\xe0\x00\xda\xf7\x85\xad\x08\x3e\xad\x67\xb4\x11\xb3\xe1\x69\xbf\xa1\x15\x32\x3e\x65\x18\x91\x04\x90\x86\x55\x49\x23\xc2\x4c\xb8\xaf\xee\xe1\x8b\xd4\x0f\xa9\xfb\x95\xec\xa3\x0d\x0e\x2a\xde\xd7\x64\x0f\x27\x44\x0b\x0a\x25\x80\x50\x09\x0b\x8b\x70\x15\x8a\x6b\xcc\x2a\x4d\xca\xec\xb3\x92\xbc\xb4\x1f\xa7\x7c\xe7\x7b\x0a\x2a\x88\xaa\xf0\x2b\x74\x5f\xf8\xca\xf5\x6e\xf7\xc6\x4e\x36\x74\x70\x55\xf5\x03\x91\xcb\xe3\x1d\x76\xf4\xbc\x4f\x77\x2f\xd6\xe9\x70\xf8\xe1\x63\x15\x46\x39\xc7\x06\xb1\x9d\xb2\xb4\x9c\x83\xf6\x62\xaa\xf5\xd6\x6c\xe0\x3e\x93\xfa\x78\x90\x73\xbb\xfb\x5a\xbc\x0b\x08\xb6\xa8\x7c\xf3\x9c\x17\x70\x57\xfb\x85\x03\x14\x0a\x15\xc0\x54\xdf\x06\x74\x7e\xa7\xbe\x97\xb3\xec\x59\x8c\x03\x8f\x1e\xfd\xf3\xd6\xe7\xc9\xa6\x9e\x4c\xa6\x2d\xa5\x72\xe6\x15\xcc\xa6\x39\x7d\xba\x94\xf8\x09\x4e\x11\x5f\x77\x8f\x7b\x76\x2c\xf1\x87\x1e\x76\x5f\x71\x52\x84\x82\x17\x48\x23\xee\x0a\x1a\x1e\x9b\x36\x36\x07\x1a\x60\xac\x35\xab\x05\x23\x92\xc4\x3f\x03\xbb\x8f\x78\xed\xa5\xbb\xdf\x3d\x05\x2a\x42\x96\x0b\x3e\xdf\x2c\xc5\x21\x28\x39\x90\xb8\x6a\xd4\x7a\x4a\xfb\x28\xc7\x79\xc1\xb3\x22\xbd\xc4\xea\x28\x2c\x9e\x9e\x50\x11\x3f\x1a\x28\x69\x97\xee\xd9\xb5\xf5\xc1\x5d\x18\x19\x44\x23\x21\x65\x7c\x7c\x53\xe1\x20\x49\x42\xef\x4f\xb0\xaf\x9b\x26\x2e\x06\x1e\x29\xfa\x6c\xe4\x15\xeb\xa9\x48\xda\x28\x1d\x75\xc6\xdf\xb3\x28\x09\x85\x38\x04\x94\x20\x7a\x05\xfd\xb9\x75\xdd\xa8\x30\x7c\x66\x9c\x66\xfa\xf8\x21\x1d\xfc\xf7\x71\xa3\xf3\x2e\x8f\xfb\x31\x0d\xdd\x23\xe4\x75\x6e\xdc\xb7\x84\x46\x10\x15\x74\x37\x60\x9c\xa2\x95\x60\xc6\x56\xde\x78\x60\x28\xc9\xaf\x9d\x62\x7a\x4a\x9f\xeb\x30\xb6\xd8\x56\xa3\xd9\x1e\x07\xd6\xd2\x83\xdf\xa4\x0a\x11\x2a\x9d\x7d\x59\xea\x06\xa4\x72\x4b\x07\xd6\xaa\x34\x7b\xe5\x22\xe3\xc4\xc6\xf6\x14\xa9\x0a\x58\x2e\x47\xbb\xdd\x1b\x42\x50\x4f\x6d\x86\xe0\xbd\xab\x03\xe9\x2b\xdb\x86\xd9\x2b\xe8\x97\xec\xc5\x55\xbf\x1e\x73\xdf\xcf\xb8\xa7\xd9\xd1\x3e\x1e\x40\xf9\x07\x5b\xc5\x48\xea\xd4\x5e\x3d\xd3\x26\x6f\xec\x05\x12\x92\xfb\x08\x9d\x1d\x39\x53\x57\x09\x19\x16\x44\x10\xe5\x72\xa3\xd0\x40\x40\x76\xd0\xaa\x9e\xf5\x63\x4a\x79\x87\x25\xbd\x8b\x31\xe4\x6b\x93\xd9\x2f\x07\xc3\xe4\xde\x04\xc5\x23\xbc\x44\xce\xd2\x2b\xcb\x8c\x92\x58\x2f\x7c\xe5\x3f\xd9\x48\x1c\x2d\x49\xe1\x2d\x96\xf3\x78\x69\x83\x1e\x7e\x73\x03\xc2\x8d\x7a\x90\x02\x01\xd9\x1b\xbd\x40\x46\x19\x08\xcc\xd1\x19\x8c\xed\x23\xbc\x12\x4a\xa3\x8d\xf9\x15\x5d\xfa\x55\xab\x11\xc7\x11\xea\x01\xf5\x28\x49\x24\xe0\x13\xf0\xc6\x25\xae\x9d\x01\x0a\xc5\x3c\xfa\x0d\x09\xae\x5d\xa8\x89\x30\x3f\x42\x87\x28\x18\x11\x07\xb1\x42\x62\xf5\xe4\x43\xe8\xcb\x04\x7f\x08\xf2\xc0\x04\x5d\xe0\x4a\x44\x1f\x17\x96\x05\x53\xdf\x19\xb1\x66\x0a\xeb\x89\x20\x37\x81\x41\xbf\x9f\x26\x74\x5e\x83\x7a\xbf\xbf\xdf\xce\x91\x1e\x51\x87\x66\x66\xde\xc0\x23\x4e\xaf\x6e\xda\xf2\x1c\x07\x8b\x0a\xa4\x12\x44\x28\x1c\x57\xf6\xee\x45\x7d\x2f\xeb\x0a\xb9\x7c\x4a\x89\x89\xf0\x4f\x00\xe7\xd2\xf9\xb3\x77\x2a\x7d\xfb\xc1\xcb\x6d\x2e\x96\xf3\xec\x1e\xb5\x27\x1e\xdf\x0e\x7d\x3c\x0c\xe3\x85\x54\x61\x46\xc9\xf5\xfb\xd0\x58\xba\x9d\xe3\x6d\xc5\xa4\xa2\x69\x40\xfb\xba\xde\xd8\x60\x9b\xe2\x61\x27\x9b\x9c\x37\x84\xa6\xcc\xb9\x72\x54\x50\x48\x54\x86\x61\xb3\x0a\x61\x51\x34\x08\xe4\xa6\xe6\xb2\x22\x69\x9a\x86\xa5\x35\xb7\x58\x37\x65\xc1\xf6\xb0\xe3\x93\x6a\xc8\xaa\xa0\x9c\x3a\x9a\xcc\xd2\xbb\x29\x2d\xb5\x1b\x54\xf6\xd6\x8f\x97\xc3\xaa\x66\x66\xa1\x64\x32\x26\x82\xda\x3a\xa2\x11\x8f\x9c\x9e\x0f\x0e\x8c\x44\xb8\x52\x65\x42\x2a\x98\x82\x0c\x2e\x76\x1e\x7e\xcf\x73\x92\x28\x66\x2e\x7d\x05\x76\x39\x96\x7d\xe1\x24\xbd\x18\x84\x92\x49\x25\x2e\x4c\x7d\xc0\x0b\x9b\x98\x17\x7f\x81\x6d\x7d\xff\x7b\x26\x02\x1c\xf6\x46\x97\x38\x3d\x38\xe6\x69\x24\xb2\x34\xf5\x43\x8f\xaa\xf6\xf3\xbf\x8b\xc2\xca\x97\x51\xf4\x22\x02\x3b\x30\x1a\x1e\xc8\x8a\x35\x1e\x95\x7b\xdc\x82\xaf\xcc\x4f\xd1\x4d\x1d\xc8\x80\x3a\x10\x15\xa5\xc2\xb3\x02\x9a\x2b\x69\x2f\x1d\x04\x61\xa8\x4d\xdb\xb0\x67\xc2\x66\xd7\xd0\x1d\x6b\xce\x58\x33\x33\x7b\x6f\x80\x8c\x03\x8d\x3f\x70\x7e\x35\xf1\x0c\xaa\x35\x7c\x23\x93\xcf\x74\xd9\x91\x27\xe3\xe1\x0c\x53\x53\x5e\xdb\xf3\xb9\x17\xf6\x2d\x40\x18\x7f\x39\x83\x07\xef\xad\x4c\x9a\xfa\x5f\x6e\xbe\xe7\x9d\x55\xb9\x96\xdb\x8f\xc4\x0b\xe8\x03\x77\x76\xf9\xf5\xd3\x00\xda\x4d\x5f\x16\x0b\xb7\xa8\x6e\x7a\x7a\xf7\xc1\xf9\x1d\xfc\x3c\xc0\x68\xb8\x7e\x93\xe9\xff\x5a\x85\x2f\xf9\x41\x64\xaa\x93\x56\xb6\x35\x26\xd5\x6c\x5b\x26\x36\x36\x8d\xaa\xd8\xd6\x2b\x49\x18\xdb\x6d\x8b\x56\xc5\xb4\x6b\x58\xb5\xac\x54\x54\x11\x8c\x51\xaa\x22\x99\xac\x54\x51\x46\x99\x06\xda\xd8\xd4\x6b\x59\x4d\x8b\x45\x45\x5a\xa3\x5b\x68\x35\xa6\xc9\x22\x48\xa3\x51\xb5\xb5\x9d\x7e\x55\x55\xd1\xb6\xfd\x9c\xe6\xd5\x45\xaa\xea\xda\xe3\x60\x23\x43\x22\x8c\x69\x71\xc4\x67\x4e\x56\xe2\x50\x2d\xdb\x9c\xb6\xdf\x33\x5a\xe2\xd6\xc6\xd6\x91\x11\x90\x20\xc8\x11\x91\x61\xd3\x4e\x56\xec\xf6\x9d\xbe\x4e\x01\x6a\xeb\x8e\x13\xba\x39\xd9\x75\x73\xa7\xb7\x3e\x9d\xe6\x57\x33\x5a\xfd\x5c\xdf\x8b\x6d\x96\xb1\xb4\xad\x4a\xd4\xad\x42\x69\xc4\xc6\xa5\x6a\x56\xa5\x6a\x56\x2d\x65\x4a\x95\x2a\x56\x2c\xad\x65\x6b\x2a\x22\x29\x5d\x5a\x2c\x8b\x89\x72\xca\xc7\x51\xba\x54\xae\x1e\x2e\x93\x56\x0e\x8d\x32\xa6\xbc\xb9\xcd\xbd\x46\x91\x3e\x6d\xf5\xce\x67\x4b\x93\xea\x5f\x5a\x36\x82\xaf\xb2\x5e\xf9\x33\x2d\xf6\x7b\x13\x49\xac\x2a\xcc\xf5\xa0\x3d\xa4\x48\x22\x7a\x86\xac\xae\x4e\x05\x1a\xeb\xf0\x9f\xac\x3d\x92\x46\xfd\xb1\xf6\x57\xab\x74\xb3\xac\xfa\xdf\x46\xe9\xf3\x60\x6c\x44\xe5\x6c\xa3\xec\x97\x2e\x14\x04\xa7\xcd\xf9\x3c\x04\x80\x01\x23\xea\x76\xb7\x1e\xfa\xdb\x5c\x16\x42\x2d\xa7\xed\xb9\xae\x34\x6b\x58\x00\x58\x41\x0d\x7d\x69\xfd\x56\xea\x29\x9b\xfe\x0d\x1a\x64\x0b\xf7\xdd\xa7\x0c\x58\xf5\xf7\xe5\x67\x6e\x77\x98\x1a\x71\xfc\xa9\xa6\x4d\xf3\x42\x18\x32\x13\x48\xb0\x87\x0c\xbc\xb8\x65\xd1\x6e\x34\x6b\xde\xf7\xbd\x37\x5f\x21\xa3\x4c\x48\xd4\xb1\x49\xa5\x16\x20\x26\xa2\xa5\x28\xa1\xa8\x41\xb3\x31\x46\x68\x49\x12\x49\xb2\x65\x28\xa6\x99\x90\x98\x62\x68\x98\xa5\x14\x6a\x24\xd3\x22\x14\x51\x14\xb5\xb2\x96\xa9\x4d\x66\x31\x8d\x8d\x2c\x99\x2d\x96\x80\xca\x40\x98\xcb\x14\x23\x22\xd1\x8a\x52\xda\x34\x25\x65\x9a\x86\xb3\x6d\x06\xa1\x51\x98\x23\x16\x48\x11\x84\x48\x41\x91\x70\x37\x42\x99\xd9\xca\x8a\xe7\x39\x4c\x6e\x9c\x05\x50\x07\x55\xcd\xc7\x27\xa2\x35\xbe\x8f\xe5\x91\x49\x5b\xd5\x13\xb7\xf2\xb0\x4d\xbe\xd6\x75\xba\x44\x44\x86\xd6\xb4\x5b\x58\xd5\xb4\x6a\x53\x56\xa9\xa8\xa4\x64\x88\xa8\xf4\x41\xf6\xf9\x4b\x7a\x92\xd6\xb5\xbc\xfc\x53\xeb\x90\xbc\xd0\x21\xb8\xa9\x16\xda\x36\xdb\x16\xda\x36\xab\x50\x55\x16\xcc\xa8\x8a\x66\x28\x58\x9a\xd0\x5b\x6a\x0b\x6a\x9a\x40\x80\xab\x05\x5f\x1c\x40\xb0\x82\xf1\xf4\xe9\xd2\x54\x80\x7d\x3c\xeb\xef\xf5\xbe\x04\x22\x20\xa9\xab\x0a\xc1\x65\xb4\xf3\x4f\xaf\xb7\x5e\x33\x9c\x77\xe2\xd2\x1d\x23\x21\x4e\xd1\x49\xbb\x74\x90\x90\x47\x57\x64\x93\xef\x36\x46\xd0\x4e\xa4\x2b\x14\x0a\xec\xb5\x5b\x34\xc6\xaa\x4a\x92\xd8\x61\x6a\xb1\xb5\x59\x64\xc5\x2a\xd8\xc1\x50\x5a\xa3\x6a\x34\xd2\x8d\x6b\x1a\xd8\xb1\x99\xa3\x46\x8a\x69\x12\x48\x20\x9a\xf1\x11\xa6\x08\x54\x1f\x58\x81\x6f\x59\x45\xa5\xe0\x9e\xb0\x34\x35\x8d\xfa\xe1\x97\xeb\x9a\x72\xf5\x9f\x55\xc0\x2b\x04\x40\x7b\x4b\x11\x11\x86\xac\x31\x0c\x8c\x23\x0a\x90\x9f\x76\x5e\x98\x4e\xd3\x3b\xb5\x80\x69\x90\x29\x62\x0f\x68\x20\x51\x08\x44\x09\x11\x03\x5b\x99\xad\xef\xad\x4f\x12\x40\x40\x3e\xb7\x99\x7d\xf2\x57\xaf\x55\xeb\x3d\x19\x9b\xc2\xb3\x73\x76\x32\x58\xbb\x62\x0c\x42\xc5\x56\xdb\x66\x81\xca\xa9\x9a\x0f\x5d\xb6\x77\x23\x33\x36\x68\x55\x1d\xba\xd0\xe6\x15\xca\x9a\x51\x57\x9b\x58\xe2\x72\xaa\xab\x2a\xaa\xa7\x5e\x4d\x1a\xcc\x99\xac\xbb\xbb\xca\xaa\xaa\xca\xcd\xcc\xcd\x18\xb3\x0a\x99\x3b\x35\xb5\xa9\xc4\xb8\x45\x46\xc2\x88\xdd\xd9\xa7\x59\x1a\x1c\x16\xe7\x73\x5d\xd6\x1d\x4f\x06\xec\x41\xbb\x42\xb6\x71\x68\xc3\x22\x6f\x25\x3a\xa8\xb7\x8e\x2e\x4b\xba\xcb\xa4\x26\x86\xc6\xb5\xb1\x91\x31\x97\x93\x35\x97\x92\xb5\xcd\x2d\x6e\xea\x96\x63\x55\x90\xf6\xe2\xf2\xde\xd7\x37\x75\xd6\x6c\x64\xb9\xc3\x57\x0b\x28\xd6\x6f\x9e\x11\xf4\xe2\xf9\xe7\xb8\xe4\x85\x00\x86\x06\xb8\xbe\x2e\xf8\xe7\x9e\x6f\xec\xf9\xa0\xec\xdd\xc0\x24\x31\xf4\x75\x67\x61\xcf\x39\x6b\xdf\xb1\x53\x5e\xd2\x3e\x3e\x25\x44\x54\xef\xc7\x7c\x04\x8f\xa3\x9a\x69\x78\xb5\x47\xa9\xec\x13\x79\xeb\x6d\x65\xcc\x8f\x51\x55\xa9\xe8\xd4\xc1\x79\x85\xc7\xcf\xc6\xb9\xf1\x47\x99\x01\x43\xce\x7b\x11\xcf\x8d\xb1\xba\xbd\x9f\x7d\x5f\x90\x94\x28\x9f\x6c\x1b\xa8\x88\x7a\xad\xd1\x49\xd5\x8a\x15\x09\x55\xc1\x7a\xa4\xe2\x87\x8b\x73\x75\xbd\xd6\x92\x73\x1a\x63\xdf\xdb\xe9\x7b\x9d\x47\xcf\x3a\x7b\xeb\xde\x15\x4f\xb6\x8a\x93\xdd\x93\x1d\xee\x04\x9b\xcc\xad\x33\x03\x64\x5d\x1a\x93\x95\x70\x6a\x20\xd5\xb1\x90\xd9\x3a\xdb\x6d\x5e\xf5\x35\x81\xf9\x1e\x0f\x81\xee\xeb\xcb\x47\x9b\x3d\xa7\xae\x3a\x1e\x23\xa5\xdf\x5a\x89\xf1\x6d\xe5\xeb\x31\xad\xf2\x3b\xf5\xbf\x9b\xa0\xf0\x79\xeb\xaa\xe4\x47\x65\x8e\xe3\x95\xe4\x28\xa8\x1a\x82\xf1\xb5\x0b\xe6\x91\xa6\x4b\xa7\x68\xb6\xc3\x14\x25\x1a\xf9\xb5\x2a\x99\xcd\x75\xbb\x85\xde\xd5\xc6\x21\x75\x59\x59\xb2\xfe\xde\xb6\xf4\xde\xd7\x7b\x27\x33\x64\xc1\x1d\xb6\x46\xb4\x25\x6d\x22\x2b\x4e\x62\x9b\x87\xb6\xea\x04\xce\xcc\xb8\xb1\x28\xa8\x88\x9c\xc8\x8b\xcc\x78\xe2\x77\x36\x2e\x73\x67\x55\xe6\xba\xa4\x8c\x5c\x3d\x8c\x6e\x66\x1d\x66\x66\x45\xc4\xa2\xa5\x18\xcd\x6f\x43\x6e\x75\xb9\xa8\xbb\xd9\x89\xab\xd2\x75\x82\xdb\x73\x4d\xe4\xa8\xab\x6d\xe9\x86\xf3\x26\x6e\x6d\x28\xbd\xdc\x87\x55\x35\xb1\x46\xf5\x3b\x54\xc4\xcc\xde\x03\x74\x9c\xea\xd2\x14\xe3\x88\x17\x13\x6e\xf2\xdc\x2a\x9c\x46\x22\x08\x83\x11\x2f\x54\xac\x9d\x19\xb2\x16\xce\x3b\xb7\x33\x73\xae\x46\x25\x35\xb7\xa2\x2d\x19\xcc\xa8\xcd\x6c\x39\x8c\x90\xe8\x46\xab\x8b\x9c\x2d\xd5\x5c\x2c\xb3\x4d\xb3\xb5\x22\x37\x68\x42\x0e\x52\xc1\xad\xd2\xab\xca\x32\xd5\xce\x44\xaa\x79\xb3\x26\xe4\x7b\x7b\x7b\x82\x3b\x5d\xf5\x1b\x0f\xc2\x7d\x59\x33\x74\xa8\xfa\xa3\x57\x11\xe9\xd4\xb8\xb6\x1a\x6a\x46\xba\x73\x86\xdb\xcd\x98\xac\xb8\x5a\xb3\x2e\x05\xd2\xbf\x07\x80\x92\x49\x79\x9e\x0b\x8f\x3c\x57\xde\xc7\x84\x54\x35\x14\xae\xf7\x23\x5c\x39\xb9\xb0\xf4\xbc\x71\x53\x54\x71\x0a\x91\x13\x1a\x2a\x62\xa4\xa4\xb0\x9a\xb3\x4e\xa8\xdd\x43\xc3\x51\x2e\x5e\x29\xe1\xda\x30\xb3\x26\xae\xf7\x77\x72\x0d\x56\x8b\x2a\x0d\x44\x46\x56\x6e\xba\xb1\x59\x59\x52\xe2\xb1\xa9\x9d\x9c\x90\xdb\xd3\x6b\x75\xc5\x36\x76\xe9\x51\xd7\x4d\x0c\xdb\x71\xe7\x94\xe6\xb1\xd7\xa1\x8a\xbd\x1b\x85\x1b\xe8\x0f\x33\xc6\x62\xa2\xa9\x35\xbe\x0e\x58\x65\xe4\x39\x79\x5d\x37\x9b\x45\x55\xf4\xbb\xe4\x5d\xc5\x59\x14\x4b\x62\xdd\x69\xb6\x89\x24\xd6\xac\x6b\x56\xe5\xf3\x7e\x64\xc6\x36\xce\x10\xb0\x90\x92\x22\x57\x11\x24\xd2\x4b\x45\x6c\xd1\xaa\x8d\x32\x49\x55\x14\x71\x44\x0a\xb5\x22\x2e\xf0\x51\x75\x9b\x50\x51\x99\x10\xb6\x0c\x0a\x25\x1d\x3b\x93\x6c\x0e\x41\x00\x6f\xa2\xe6\x72\xd4\xd8\x04\xb9\x0c\x0e\x33\x9e\x3a\xcd\xbb\xe4\x75\x97\x26\xb1\x82\xe5\x70\x89\x24\x8a\x90\xe7\x61\x3b\x0a\xc8\xa8\xb8\x40\x9a\xbc\xca\xa2\x6a\xa3\x26\x34\x33\xce\x00\x9d\x51\x06\x34\xc4\x13\x79\x37\x69\xa9\x83\x98\xa1\x2a\x95\xc4\x60\x22\xe1\x8e\x64\x5c\xe5\xd3\x98\x9a\x11\x11\x16\x33\x80\x0a\x14\x64\xe8\x84\x4a\xa8\x8a\xe0\x22\xf5\x29\x9e\x01\x9b\x55\x14\xb6\xc0\x1c\xd9\x96\xa2\x61\x31\x02\x62\x76\x34\xdc\x44\xe0\xa6\xac\xc6\xf1\xd7\x5a\x53\x9a\x6b\xbb\xcb\x8b\xb6\x94\xd7\x37\x22\xba\xb4\x93\x7a\x20\x07\x5a\x15\xed\x6d\xa1\x69\x6d\xb6\x8b\xc7\x35\x74\xf3\x9b\xde\x1d\xf4\x5e\x17\xed\xed\xec\xfd\x4b\x7b\xeb\xef\xde\x5e\xd7\xb3\xdf\x0b\xf2\xc2\xb0\xab\xc0\xae\xe2\xdd\x70\x34\xb6\x21\x6e\xe9\x95\x98\x31\xfe\x14\x68\x9c\x15\x92\x90\x90\xae\x90\x56\x07\xb0\xfd\x7e\xc9\x49\x1a\xb2\x49\x2c\x55\x43\x81\x22\x1a\xf5\xd9\x9c\xc9\x24\x2c\x80\x20\x58\x95\x1f\xd8\x94\x24\x92\x1e\xc0\x28\xb9\xbf\xe3\x8d\x72\x35\xb8\xd7\x08\xf0\xe3\xe9\x28\x36\x5e\xdc\x2e\x99\xb7\x08\xee\x39\x32\x45\x75\xa1\xa3\xc9\x82\xca\xb0\x59\x3d\xdf\x6d\x87\xa5\x44\x41\x9a\x22\x12\xe9\xef\x16\x5e\xa7\xdc\xce\x2e\xcd\xac\x36\xdd\x77\x86\x12\xf0\x90\xe5\x7e\x3c\x97\x64\xd6\xab\xc5\xda\x33\x0d\xcc\x0d\x88\x9c\xc7\xe4\xac\x18\x20\x6e\xb5\x6f\xb0\x07\x75\x24\x92\x61\x1a\x08\xcf\xab\x96\x70\x33\x69\xff\x63\xcb\x1b\x68\xde\xbb\xcf\xd5\x72\xb0\xdf\xdd\xf5\xce\xd7\xc6\x63\x6b\x05\xad\x4f\x52\x27\x8f\x16\xae\x74\xb0\x59\x6d\x99\xb6\xfb\xb0\xc2\x59\xb6\xdb\x2d\xa1\x7c\x61\x2d\x95\xd0\xf3\x9f\x3f\x8c\x02\xd1\x35\x80\xd1\x08\x6e\xe4\x16\x4b\x49\x1f\x08\x35\x14\x8c\x0d\xd5\xc2\xc6\xdc\x77\x60\x4b\x6f\xb6\x03\x19\x33\xcf\x4f\x65\xf7\xe1\xef\xf3\x50\xa4\x47\xf0\x0f\xba\x28\x58\x3f\x7a\xa0\xfd\xe8\xfc\x7c\x8c\x9b\xdd\x2c\xf2\x5e\xad\x82\x0a\xb5\x16\x51\x37\xb7\x75\x7b\x80\x90\xd6\xc5\x12\x49\x04\x93\x54\xac\x89\x23\x9c\x55\x9c\xe0\xb6\xb9\x71\x0a\xe9\x05\x95\x02\x06\x3d\x4e\xb4\x5d\x18\xc4\x4e\xc8\xba\x93\x48\x94\x41\x34\x51\x24\xe2\x83\x07\x14\x98\x24\x4b\x19\x92\x20\x72\x90\x57\x8e\x9c\x97\x12\x9e\x48\x1c\xcd\xae\xef\x79\xa3\x4b\xdb\xdc\x9c\x62\x6c\xa7\x02\x48\x63\x00\x2b\x24\x26\xad\x8b\x00\xc6\x1b\x71\x26\x25\x56\x57\x8e\x1c\xae\x34\xe2\x97\x76\xab\xa6\xad\xc3\x83\x6d\x44\xda\x49\x46\x67\x26\x61\x24\x94\xd1\x43\x4e\x59\xb8\x37\x1a\x09\x9e\x55\xe3\x6c\xef\x6b\x65\xa6\x26\x06\x5c\xf9\x7d\xa4\x9c\xea\xe1\xb6\x48\xbe\xe2\xa8\x7d\xf6\xf0\x34\xcf\xc2\x18\x53\x97\xc0\x80\x20\x7f\x18\x80\x00\xbd\x90\x0e\x5a\xd0\x85\xa2\x81\x50\x14\x57\xc7\xa5\x08\x80\xa1\x78\xea\x20\x80\x57\xb3\xc6\x2b\xd9\x59\xde\x7d\x0e\xd8\xc5\x6f\x1a\xd4\x44\x2c\x06\x2e\x2b\xa6\xb0\xe4\xcd\xdc\x51\x83\x6f\x12\x95\x34\x66\xde\xb1\x99\xb6\x23\x44\x15\x32\xe2\x8d\xd6\x64\xe6\xb8\x89\xa8\x6e\xf6\x6b\x45\xbc\x3b\x51\xb2\xa8\x18\x2a\x2e\x2e\xf2\xee\x0e\x25\x8c\xa3\xb5\x94\x4f\x07\x06\xae\x12\x38\x52\x29\x48\x36\xc6\xcc\xce\xce\xe0\xd5\xc0\x05\xa7\xc0\x01\xd2\x49\xe2\x87\x93\x13\x5a\x71\xe3\xbe\x00\x37\x81\x63\xaa\xcd\xdb\x4e\xdf\x00\x33\xa8\xcd\xed\x1c\xbd\xde\x01\x5a\x24\x6b\x39\xb1\x69\xeb\x20\x09\x61\xcb\xcb\x5b\x87\x5d\x00\x25\x8c\x7b\x77\x9b\xb6\xf2\x6c\x70\x30\xe5\x9c\xab\xd3\xae\x68\xbc\x98\xce\xb5\x37\xbc\x67\x71\x33\x13\x78\xce\x73\x8a\xde\xf7\x9d\x66\xfa\xa1\xec\x02\xa0\xf9\xc1\x10\xce\x2f\x39\xd6\x14\x00\x30\x73\x9a\xba\x0a\x12\xfd\xef\x6c\x77\xb7\x79\x2d\x6b\xf7\xe2\xd4\x7c\xcb\xdd\x89\xd5\x55\x98\xbc\x98\xc8\xa3\x96\xd1\x19\x74\xf5\xe6\x56\x4d\xca\xb1\xb8\x41\xd8\xd3\xa9\xf1\x29\x26\xe1\x0a\x12\xf6\x66\x71\xa1\x50\xc8\x33\x3a\x2d\x43\x17\x70\xcc\x5f\x1c\xbd\x67\x9b\xde\xef\x8c\xe6\xab\x06\xfa\xa4\x54\xa9\xa9\x6a\xa6\xa0\xa3\x44\x10\xa8\x49\x90\x19\x09\x8c\x80\x1e\xb8\xb8\xac\x26\x86\x36\xac\x95\x71\x54\x09\x07\x33\x0f\x1c\x34\x57\x1c\x3d\xe4\x5c\xe0\xb0\x88\x09\x04\xa2\x02\x15\x42\x28\x55\x1b\x4c\x86\x50\x52\x16\xd8\x5a\x1b\xdf\x7b\xcf\x1e\x7b\xdf\x7e\x73\xbf\x12\x48\x10\x7b\x22\xa8\xa8\x14\xc1\x09\xc3\xa9\x28\x4a\x04\x04\x84\x72\x80\x0b\xca\x6b\x17\x9c\x6f\x89\xe3\x54\xf4\xb9\x21\x88\x95\x04\x90\x42\x45\x4e\xa0\x42\x1d\x67\x73\x7b\xeb\x39\xe6\xb1\x8c\xf6\xe4\x93\xb4\x14\x14\x82\x2c\x8a\x78\xab\x63\x50\xef\xc7\x6f\x46\xb5\xae\xfc\x3d\x13\xa4\x1e\x20\x28\x0a\xaa\x28\x8c\x14\x15\xa0\x4d\xf9\xde\xf3\x9e\xf7\x39\x9e\x1a\x91\x90\x64\x02\x46\x41\x91\x91\x49\x10\xe6\x3a\xed\xae\x67\xae\xb5\x7d\xf8\xf3\xe0\x09\xd7\x94\x90\x59\x0b\x18\x62\x62\x0b\x05\x81\x92\xd2\x16\xd2\x98\xd7\x59\xde\x39\xd6\xbb\x4e\xc8\x0e\x15\x90\x03\xb1\x24\x24\x42\x41\x91\x90\x4d\x75\x96\xc3\x4c\x34\xd7\x5c\x26\x7a\xe7\xaa\x33\x35\x90\x0a\x0a\xa5\x24\x12\xaa\x83\x94\x95\x90\x58\x06\x0c\x95\x27\x8e\xb6\xf8\xf1\xe3\x7e\x7a\xe9\x7b\x6b\x0b\x10\x85\x40\x59\x22\x90\x52\x0b\x03\xcf\xae\x0f\x48\x8f\xa7\xce\x53\x6f\xae\x6f\x8e\xfa\xcc\xf4\x54\x4f\xa7\x90\x53\xb1\x7b\xa8\x2d\x53\x39\x90\x45\x49\xcb\xa5\x7a\x61\x1e\xa4\x5a\xb7\x0c\x54\x1a\xdc\x72\xd1\x9b\xcc\x88\xcc\x86\xd5\x56\xdd\x09\x90\x8e\xd4\x13\x5a\x33\x21\x8d\x2c\xe9\x7a\x91\x33\x96\x1c\x56\xcc\xcd\x45\xbb\xab\x85\xe8\x73\x88\xf0\x91\x87\x9c\x04\x21\x20\x14\x1c\x2b\x36\xd0\x85\x28\x00\xb9\xbd\xcd\x8c\xe2\xe7\x7c\x58\x01\x0c\x00\x49\x22\x0a\x82\x4b\x66\x2e\xa5\xb0\xde\xc5\xef\x52\xae\x94\x09\x24\xa1\xcb\xdc\xcd\x5b\xe6\x6b\x59\x9b\xbb\x01\x19\x4a\x9c\x24\x90\x49\x48\x99\xca\xcc\xdc\x4d\xd0\x38\x30\x20\x01\x90\x38\xd2\x8c\x8a\xdc\x69\x99\x72\x28\x52\x25\x41\x08\x66\x0c\xe5\x6f\x4e\xab\x93\x76\x80\x25\x82\x76\x01\x7b\x76\xf6\xbb\x9b\xdd\x39\x75\xde\x10\x6c\x8e\x14\xb8\x91\x3d\x19\x5d\xce\xf7\x66\xfa\xf7\x6e\x3a\x50\x24\x93\xca\x43\xb9\x9b\xab\xbd\xcb\xd0\xe0\x22\x92\x5d\xe2\x44\x92\x41\x27\xce\xf9\x9d\xc3\x99\x5b\xc9\x85\xbc\x03\x41\x40\x25\x08\x24\x15\x9d\xc8\xd2\xae\xf5\x78\xef\x70\xda\xc9\xa8\x30\xef\x94\xc1\xc8\xbb\x17\x91\x77\x00\xc8\x57\x36\xab\xca\xc7\x55\x3d\x53\x5d\xdc\x62\x22\x73\xbb\x3b\xba\x95\x3c\x14\x26\x64\x82\xea\x33\x1a\x48\xd0\xbc\xcc\x6a\x94\x6e\xe5\x55\x93\x98\x5d\xf1\xd1\x7a\xef\x07\x53\x8e\xbb\xcd\xe9\x6d\x64\xe9\x98\x90\xf5\x6e\x92\x6a\xdf\x43\x20\x11\x28\x85\x94\x7b\xe7\xaf\x5b\x17\x1e\x74\x73\x96\x4a\x3c\x24\x92\x08\x24\xac\xcd\x4d\x3c\xdb\x8d\x13\xc2\x49\x24\x92\x49\x24\x98\xaa\xcb\x98\x59\xdd\x60\x10\xc9\x28\x24\x88\x44\x94\x52\x09\x6e\xd6\xb9\x75\xea\xa2\xfa\xc3\x24\x92\x7b\xc2\x8f\x09\x43\xb5\xbb\x1d\x7a\xbd\x2d\xdd\x04\xb0\x14\x90\x6d\x0a\x90\x51\x97\x51\x99\x27\x71\x96\xcd\x83\x30\x26\x14\x00\x14\x78\x09\x01\x10\x1b\xa5\x9d\xce\xc3\x8d\x1c\x03\xcc\x77\x72\x54\x31\x83\xba\x38\x17\x26\xbb\x79\x0b\xc7\xd7\xc1\xd2\x05\x8a\x26\x48\x70\xd4\x43\xab\xe8\x6f\x91\x04\x90\x46\x8c\x0c\xf6\x34\x42\x76\x6a\x7b\x97\x16\xaf\xc8\x4b\xca\x0a\x37\x69\x54\xe4\xc5\xa7\xcd\xdc\xc1\x94\x26\x8c\x26\x7b\x6e\x4a\x9b\x99\xbe\x90\x54\xcd\xc3\x55\x0f\xa0\xd1\x31\x05\x68\x32\xc9\xb7\x18\xd9\x4d\x64\xe1\xad\xdc\x9d\x8b\x82\x6a\x1e\xc3\xdc\x71\xae\xe6\xad\x5c\x79\xa4\x15\x21\xc3\x02\x0a\x90\xa5\x14\xa7\x0a\x03\xb0\x71\xb0\x8e\xee\xaf\x95\xab\xac\xc8\xb9\x7e\x2f\x00\x2a\x09\x20\x92\x49\x20\xe0\x31\x76\xb7\x76\xd6\xd1\x59\x51\x88\x02\xb9\x24\x12\x49\x27\x87\xbd\x76\xda\x87\x9d\x3d\xe7\x68\xf3\x91\x7d\xd7\xb2\xaf\x30\x53\x60\x54\x90\x23\x29\xb8\x0d\x9d\xcd\x08\x1e\x71\x05\x5a\x37\x63\x37\xcc\x8d\xf2\x3a\x09\x24\x92\x78\x23\x99\xaf\x61\xf7\x0e\x6b\xf3\x94\x20\x92\x29\x20\x2b\x1b\xb7\x36\xa3\xbb\xa6\x87\x24\x81\x79\x7b\xa2\x69\x58\xdf\x1f\x97\xce\x69\x14\x06\xe6\xd7\x77\xb3\x7b\x9b\xe0\x8e\x01\x91\xdd\x17\xa1\x4b\xab\xe7\x38\x2b\xcf\x0e\xf8\x0b\xa9\xcc\x4e\x32\x75\x37\xb7\xaa\x24\x44\x03\x61\x5d\xd2\xd1\x37\x59\x8e\xe0\x54\x56\x3c\xdb\xeb\xda\xa4\xd4\xe6\xb8\xee\xc4\xc2\x3d\x45\x42\xab\xdd\x8b\x75\x1b\x09\x6d\x54\x55\xa6\xe5\xe6\xd5\x6e\x91\x73\x34\x2c\x53\x4d\xb3\x8a\xb6\x43\x24\x48\xce\x1f\x14\xe2\x0e\x35\x25\xcb\x46\xc6\x56\x8c\x1a\x6b\xc7\x3b\xe7\x3c\x71\xc7\x5d\xf9\xb0\x04\x00\x93\x17\xe7\x5c\x4b\x0d\xb6\x49\x24\x02\x41\x26\xaf\xbd\x79\xaa\xb6\x35\x8b\x90\x02\x93\x5b\xd8\xba\x3a\xdd\x6c\xc5\x62\x64\x4a\x12\x41\x04\x93\xac\xcc\x1b\xcb\x9d\xec\xb4\x5a\x40\x92\x49\x20\xf8\x92\x48\x95\xdd\xf3\xa7\x6a\x1e\xf5\x3b\x94\x49\x00\x16\xe7\xce\xb8\x6f\x5c\x28\xec\xf2\x08\x04\x92\x49\xef\x5b\x6d\xb7\x0c\x4c\x23\xc0\x0b\x6d\xbc\x65\xe8\x4f\x61\x12\x49\x24\x12\x48\x24\xb8\xc6\xa9\xee\xee\xaf\x2e\x48\x24\xe2\x44\x92\x45\x89\x7a\x31\x78\x7c\x33\x2a\x73\x18\xed\x58\x59\x46\x70\x9a\xab\x57\x35\x51\xe0\x34\x64\xdd\xe9\xab\x71\x98\x4d\xed\xaa\xbc\x89\x81\xe6\x57\x47\x6f\x31\xab\x42\x6b\x2b\xab\x27\x2e\x3b\x30\x8a\x46\xe2\x95\xe6\xad\xb9\x70\xe2\xeb\x1d\x3d\xc3\x53\x8e\xe8\xe5\xd8\xd8\xc9\xa1\xb9\x42\xf8\xcc\xe6\xf5\xdd\xe3\xae\xfb\xee\xb7\xc8\x45\x86\xda\xaa\x8a\xa0\xa4\x2b\x67\x52\x72\x34\xc6\x0a\x25\x90\x00\xa9\x01\xa3\x96\xe6\x9d\xf8\xe7\x9e\xbc\xf1\xd7\x8d\x5e\x49\x24\x92\x49\x03\x77\x4d\x5e\x6c\x6e\xc1\x44\x92\x49\x27\x83\x5e\xe5\xe5\x3d\xb9\xd1\x72\x89\x24\xf0\xe6\x3f\x2d\xee\xb5\xaf\xc0\x29\x12\x49\x3c\x27\x84\xf6\x6f\x07\x7a\xe7\xa9\xb3\x08\x2b\x20\x22\x41\x07\x78\xfc\x58\x73\x6f\x62\xa7\x7b\x7e\x0e\x08\xe2\x25\xa1\x66\x0f\x24\x3e\xca\x46\xb7\x3b\x2c\x55\x66\x8e\x73\x9c\x03\x1f\x09\x85\xc2\x92\x54\x97\x1a\xd4\x5e\x75\x79\x62\x33\x57\x7b\x55\x20\x92\x4b\xa9\x05\x59\x15\x58\x2a\x92\x05\x93\x73\x33\x37\xde\xdd\xf7\xba\x91\x24\x92\x49\x27\x42\x49\x22\x4f\x4f\x6a\xbb\xad\xe9\xdc\x2c\x92\x41\x24\x92\x49\x24\x9c\x47\x18\x0b\x55\xba\x54\x52\xde\x9a\x1b\x20\x2a\xe9\x9d\x86\x16\xdb\xbc\xd3\xc9\x17\x70\x62\xf2\xf6\x36\x66\xae\x3c\xe8\x43\xb3\x78\x35\x9a\xec\x62\xbe\xd9\xd2\xf5\x6c\xe5\x55\xe5\xc6\x55\x66\x4e\x6d\x0b\x83\xb9\xa6\x98\x92\x4b\x1a\x72\xf0\xb8\x75\x58\x75\xd6\xb9\x7b\xd7\x1d\x75\xd5\xdd\xbd\xf5\xdc\xf2\x11\x41\x40\x59\x15\x45\x8a\x91\x90\xa4\xac\x45\xe0\xe0\xa7\x1c\x2c\x55\x14\x05\x83\x62\xb4\x56\x34\x94\x17\x39\xcd\x21\x94\xb3\xbc\xd6\x9b\x6c\xf3\x62\x65\xb1\x89\x25\x95\x49\x20\x92\x09\x8d\x66\x54\xb4\xd0\xbb\x64\x16\x48\xef\x67\x4e\xdc\xbd\x74\x58\x16\x49\x24\x92\x71\x22\x49\x3e\x0e\xbe\xde\xae\xf9\x5d\xf0\xde\x61\x28\x49\x24\x14\x38\x84\x64\xdd\xe2\xd5\x0a\xd3\x59\xa7\x40\x1c\xd3\x36\xf7\xb2\xb5\xba\xc6\x24\x8e\x69\x85\xde\xc4\x3d\x4f\xa7\x67\xb3\xdd\xa0\x41\x04\x92\x49\x24\x83\x9c\x69\x56\x9d\xbd\xa6\xf4\x50\x24\x93\x69\x14\x45\x02\x29\xd4\xb9\x69\xe6\xde\x39\x1c\x44\xb2\xeb\x59\x3e\x5b\xef\x73\xbd\x24\x92\x49\x24\x92\x49\x25\x49\x2c\x5a\x8b\x8c\x65\xd6\x4a\xa8\xe4\x76\x42\x98\x75\x50\x66\xb2\x2e\x6b\x60\x19\x98\xee\x0d\xee\x6a\x17\xac\xda\xab\x4a\x8f\x77\x6a\x66\xf7\x6f\x71\x65\xda\xcd\x18\x62\x7b\x47\x76\x5d\x45\x22\x91\x0e\x4c\xab\x8d\x99\x9c\xc5\x5b\x35\x73\x73\x58\xb6\x6e\x73\x19\xce\xba\x54\xcc\x61\x08\x40\x64\x64\x01\x0b\x15\x82\xf2\x73\xbb\xbf\x77\x8f\x8f\x6f\x2b\xcd\x78\x30\x01\x77\xe9\xd3\x9d\xe7\x79\xb9\xcb\xbb\x20\x92\x49\x24\x90\x49\xc0\x52\xd5\x4d\xe6\xd5\xd8\xc6\x5a\xae\x4d\x66\x2e\xc9\x24\x92\x49\x24\x92\x49\xcd\x9b\x9c\xcd\x3e\x9e\xaf\x1f\x79\x82\x89\x24\x92\x49\x24\x92\x5d\x15\x73\x15\x72\x71\xf1\xf7\x76\x72\x77\x79\x60\x92\x09\x20\x92\x49\x24\x9a\x77\x10\x77\x5b\xc2\xd8\xc2\x49\x04\x92\x49\x26\xd2\x27\xc0\xf3\xb4\xa3\xb1\x89\x8b\xf2\xef\x36\xa0\x82\x49\x20\xd2\xaa\xaa\x92\x2d\xa4\xad\xea\xaf\x73\x51\x78\x99\xa0\x4e\x14\x52\x49\x24\x90\xb8\x21\x77\x98\xa6\x70\x6d\x41\x28\x49\x24\x10\x66\x49\x9d\x07\xd8\xdc\xdd\x0a\x82\x40\x3c\x20\x79\xc6\x45\x12\x0b\x55\x69\xef\x55\xed\xe7\x68\xdd\x45\x65\x45\x5d\xa9\xbb\x47\x25\x54\x41\x49\xba\x7d\x89\xd5\xc4\x0e\xed\xf6\x0f\x7b\xbc\x3a\xa6\xef\x73\x2e\xf0\xac\x85\x73\xbb\xdb\xb9\x85\x33\x18\xd1\x94\xae\x1d\xb5\x56\x6e\x0c\xe4\xe3\x98\xa9\xd1\xb1\x22\xd9\xd6\xf9\xb3\xa0\x6a\x08\x12\x28\xf6\x92\x03\xa8\x92\x0d\xa0\x04\xcd\x08\x08\xc5\x00\x4b\x33\x64\xd5\x44\x8d\xe6\x07\xd6\x5c\x20\x0c\x4a\xdd\x92\xda\xb8\x5b\xb6\xbd\x15\xc7\x04\x90\x41\x24\x03\x24\x36\x4a\x6d\xde\x3e\xd4\x60\x24\x12\x4f\x09\x0f\x0b\x8b\x79\xb5\x90\xf1\x9c\x48\x13\xc2\xed\xad\xa9\x6a\x22\x3c\xef\x6d\x82\x09\x04\x92\x77\x1a\xd7\x7a\x0a\xb6\xc9\x90\xc8\x24\x90\x40\xf0\x40\x2e\xfa\xb7\xbb\xd8\xb7\xdb\x3d\xb9\x18\x41\x20\x93\xc5\xb3\x8b\x6a\xf6\xf7\x6d\xc6\x0c\x24\x90\x41\x20\x90\x77\x0d\xc8\xcd\x73\x8e\xf8\x81\x24\x82\x49\x23\x04\xc4\x6d\xa9\x8b\xc7\x19\x60\x82\x4f\x09\x24\x9f\xad\xfd\x2f\x67\xa2\xaf\x4e\x86\xcc\xd9\x8c\xa6\x52\x4a\x31\x59\xa6\xa9\x66\xda\x14\xd4\xd0\x62\x69\x23\x6a\x31\x46\xd4\xdb\x68\xda\xd2\xc2\xc6\x26\xde\xf3\x92\xdf\x5d\xae\x35\xb1\x6a\x2d\x45\x6d\x17\x17\x15\x32\x86\x63\x56\x2b\x6d\xa6\x62\xd8\xa2\xa0\xac\xc3\xfb\x7b\x75\xf3\xac\x3e\x3e\xb6\xef\x6e\xdc\xc6\xa9\x16\x26\xc0\xbe\x37\x36\x52\x18\x95\x51\x5a\x36\x19\x30\xa2\xa5\x35\x42\x52\x91\x56\x89\x4d\x6c\x46\x34\x69\x24\x92\xc6\xb1\xb2\x6c\x65\x12\x94\x66\x31\x57\x7b\x00\x2a\x33\x00\xc5\xad\xc5\xb2\xda\x48\xf7\x5b\xb5\x5f\x26\xf7\x75\xde\x4b\x0c\xc7\x86\xe6\xab\x73\x35\x10\xd1\x19\x9a\xd8\xd9\x94\x5b\x26\xcc\xda\xc4\x95\x26\x99\xb6\xc4\x4d\xf6\xef\x83\xe9\x00\x00\x00\x92\x07\x4b\xac\x88\x6d\xdd\xab\x8d\xa8\x94\x4a\x31\xb0\x82\x62\x68\x45\x5b\x1a\xc5\x34\xf2\xb6\xe4\x07\xe1\x72\x95\xa5\x19\x36\x90\xac\x94\x6c\x2c\x99\x33\x4a\x43\x36\xac\x36\x63\x59\x3b\xf2\xe4\x6d\x19\xa9\x46\xa0\xad\x1b\x51\xa9\x41\x43\x36\xa6\x84\xc2\x99\x0b\xca\xcc\x7e\x6f\x27\xb3\xae\xbe\x9b\x2e\xd3\x21\x4d\x00\x92\x1b\x40\xb5\xe1\xce\x32\x29\x56\x68\x8c\xcd\xf3\xdb\x9c\xda\xf8\x65\xa2\x30\xc8\xc6\x86\x18\xd9\x57\x3f\x8d\x5e\xae\xb6\xae\x96\x55\x64\xa6\x45\xa4\x69\x6b\x57\xd6\xac\xb5\x70\x52\x26\xd9\xbf\x3a\xb9\x34\xc4\x98\x50\xc8\x9a\x35\x99\x4d\x69\x56\x54\x5b\x1b\x16\xda\x2b\x4d\x54\x58\x61\xb4\x7a\x2d\xef\xf8\x5b\xd5\xe9\xed\x5d\xaa\xc1\x1a\x36\x8c\xde\xa6\xdb\x6d\xab\x97\x8f\x36\xe7\x2b\x2e\x19\x1b\x1a\xca\x26\xd9\xa6\x34\xe7\x37\x33\x48\x2a\x9b\x44\x9b\xc5\xb9\x32\x75\x5b\x7a\x3a\x72\x32\x10\x5a\x45\x12\x93\xbd\x97\x1a\xd8\xda\x36\xa5\x0d\x64\x6c\x43\x5b\xa7\x39\xb5\x8a\xc6\xa9\x93\x4b\x5a\x2a\xd4\xa9\x54\x28\xa5\x29\x16\x8d\xa3\xdd\xf6\x39\x6e\x94\x99\xab\x1d\x57\x29\x46\x94\x98\x9a\x8d\x64\x05\x24\x34\x6d\xa8\x86\x41\x34\x06\xc8\xd6\x2c\xa6\xb6\x62\x83\x7a\xf9\xad\xc6\x4a\x66\x52\xd4\xa2\x26\xf8\x39\x71\xa5\x26\xca\xc1\x84\x9b\x4c\x58\xb5\x7c\xaa\xd7\x16\xb2\x6a\x35\x55\xc5\x6e\x24\xd4\x93\x02\xc5\x36\xf2\x38\xc9\x34\x29\x32\x4b\x2a\x4d\x7d\x0a\xdb\x93\x4d\x3a\x66\xdc\x46\xd3\x65\xb2\x59\x4c\x69\x32\x4a\x4c\x84\xd2\xbd\x6d\xca\xd2\x9a\xd2\xbf\xad\x5f\x72\x6a\x57\x3a\x65\x28\x49\x2c\x9b\x52\x9c\x55\x71\x45\x79\x1a\xae\x7d\x4f\x86\xbe\x2f\x57\xd9\xec\x96\x0f\xd4\x03\x0f\xe5\x00\xd6\xab\xcc\x92\x83\xed\xb6\xfd\xea\x1e\xf5\x76\xc4\x43\x12\xa1\xe6\x41\x73\xc9\x85\x3f\x5f\x92\x5b\x17\x3e\x06\x9c\x31\x92\x07\xe3\xb9\x52\x2c\x24\xe1\xf7\x5a\xc3\xfa\x63\x20\xf0\x6d\x41\xf2\x24\xa4\x3f\xe2\x20\x3e\x5f\xd7\xc1\x1c\xd1\x29\xf0\x10\xbf\xdb\xa7\x17\x2d\x40\x76\x4c\x6d\x61\x31\xf1\x79\x2b\xd9\xcb\xf9\x3c\x0c\x6e\x1b\x37\xba\x1f\xe3\xbe\x99\xc1\x89\x42\x78\xbb\xf5\xdd\xcf\xa5\xa1\x26\x71\x8c\x62\xee\x34\x66\xf7\x33\x9b\xa5\xad\x25\xe5\xe6\x6a\xf7\xbf\xeb\x86\x25\xda\x85\x41\x8e\x64\xf9\x20\x55\xd4\xf4\x43\x1f\xc1\x03\xfc\x5f\x2c\xa5\xe6\x65\x71\x3f\x08\x67\x4f\xdc\x31\x03\xea\x70\x7b\xf9\x80\x7f\xc8\x62\x1f\xf5\x91\x9c\xd9\xae\xd3\xb0\xfd\x2f\x4d\xa5\x2f\x50\x86\xef\x71\xa0\xde\x62\x75\x10\x3e\x68\xfc\x19\x6c\xfa\x45\x84\x8c\x20\x48\xb0\x21\x3d\x62\x01\x3f\x0f\xd6\xda\xa9\xe2\xdc\xf9\xfd\x36\x0f\x81\xc9\x42\x78\xa0\xf4\x4d\x03\xea\x87\xa8\x62\xc1\x74\x60\x35\x00\xed\xef\x50\xca\xc1\x96\xc8\x60\x40\x90\x24\x49\x02\x29\x85\x25\x5b\x8d\xdd\xbd\x7f\x96\x20\xdc\x0f\x8f\x5f\xfd\xe4\x8c\xda\x71\x78\xd6\xfd\x5d\x4d\xbe\xf1\x3b\x81\xaa\x08\x43\xe7\x11\xf9\xa8\x66\x9e\xa8\x1e\xfe\x3d\x87\x2d\xa0\x15\x61\x4c\x7a\x92\x40\x92\x42\x6e\xb3\x65\x4f\xb7\x33\x8f\xa7\xb6\x5e\x36\xab\x17\x2a\x6f\xab\x3e\x94\x12\x01\xd9\x06\xa1\x83\xc8\x1e\x35\xcc\xed\x02\x15\x11\xc1\x0a\x4f\x8e\x3c\x0f\x18\x5f\x0e\x03\xa6\xbf\x10\xa8\x21\x1e\xe0\x34\x40\x98\xbe\x91\x32\x84\xdf\xc6\xd7\x00\x2d\x05\x31\xe8\x27\x12\xfe\x07\xc2\x4c\xdf\xb1\x2f\xeb\xdf\x57\x93\xf1\x36\x22\x8f\x76\x1e\x7a\x1a\x69\x51\x18\xa8\xb6\xd5\x28\xfa\x35\xad\x70\x06\x1b\xef\x3f\x08\x1b\x77\x9b\xd6\xef\xd9\xcb\x48\x5e\x7c\xe2\x04\x21\xcd\xb7\x44\x11\xf4\xb9\x89\x3c\x57\xe8\x3d\x4f\x00\x5d\xa9\xc0\x79\xa9\x12\x1f\x27\xdf\xb8\xd4\xf0\x7d\x0a\x73\x38\x52\x35\x2a\xb5\xf4\xdf\xb3\xbc\x7f\xce\xa9\xfb\x12\x79\x6a\x8d\x91\x2d\x64\x3e\xe1\xfc\x10\x5b\xbe\x55\x72\xda\x1a\x05\xda\xff\x1e\x26\xc3\xed\x25\xd2\x44\x32\x09\xaa\x94\x11\x80\x9b\xfc\xee\xa1\xb1\x5c\x93\x11\xc7\xfe\x57\x31\x40\xf8\x0f\x78\x21\x29\xf8\xf7\x4b\xd8\xfc\xf1\x36\x11\xcd\xf4\x3f\x71\xf4\x1e\x49\x38\x14\x9a\xbd\xe5\x4d\x1a\x93\x20\x20\x50\xd2\x88\x4b\x21\xa6\x0c\x48\x20\xe6\xa9\x98\xdd\x46\xe9\x0c\x49\x27\xe2\x4f\xc0\xb3\x0d\xbf\x3f\xd3\x74\x3c\xc2\x92\xdb\x5a\x6d\xc6\x9e\x64\x0c\x38\x62\x41\x88\xa5\x26\xc9\x3e\x49\x0f\x64\x34\x87\xc6\xcd\xf5\x0a\x3d\x85\x94\x30\x70\x81\xca\x57\x30\xcd\x2d\x03\x30\x62\xe5\xd2\xd5\xfe\x92\xfa\xc5\x2f\x26\x8b\x20\x7a\x67\x32\x64\x24\x42\x3c\x78\xa5\x5a\x25\x01\xa4\x71\x25\x94\xb8\x65\x29\x55\x44\x68\x56\x2c\x05\x31\xb1\x40\x47\x20\x01\x10\xe6\x08\xcc\x61\x0a\xea\x48\xdf\xa3\x32\x2a\x92\x25\x20\xe4\x06\x39\x33\x25\x71\x88\xd6\xd7\x3a\xd6\x26\x16\x37\x56\x09\x23\x0e\x30\xc6\x0a\xb0\x66\x9d\xb8\xe5\xbb\xb8\x0f\x80\x23\xa9\xa8\x6b\x32\x12\x5a\x59\x23\x41\x8e\x52\x5c\xa8\x27\x03\xaa\x9c\xf3\x99\x74\x1b\xe2\xdd\x30\x18\x05\xb8\x5e\xe8\x1a\x53\x71\x91\xa3\x14\x3d\xbe\x64\x0e\x23\xe7\x9c\x8c\x8b\x20\x12\x0c\x8b\x23\x22\xf5\xc5\x2c\x21\xb4\xa8\x5d\xc8\x70\x85\xb3\x25\x58\x78\x66\x55\x21\x33\xe0\xd2\x17\x43\x3f\x45\xb3\x9f\x2e\xc3\x9e\xd5\x6b\x19\x00\x6a\x97\xb0\x68\xe0\x44\x02\xce\xc5\xec\x08\x6b\xc9\x4f\x43\x07\x02\x96\x8a\x21\xe1\xb5\x02\x40\x26\x44\x60\x34\x4a\x49\x03\x26\x64\x57\x04\xc7\xc7\xdc\xb0\x0b\xba\xaf\xbf\x62\xc8\xbd\xd3\x94\x18\x9f\x2b\x7b\xe7\x31\x03\x9c\xd6\xeb\x91\xa6\x12\xd4\x99\x32\xd2\x05\x92\x12\x3d\x99\x86\x71\x6e\x71\x46\xbc\x4c\x13\x49\xad\x68\xb5\xe1\x0f\x88\x3c\x52\x06\xb3\x59\xac\x3a\xc5\x6a\x00\xb9\x26\xbc\x7a\x6f\x4a\x60\xc9\x33\x2d\x44\x2b\x43\x0b\xa5\xae\xd8\xa2\x1a\xba\x3a\xd6\xb4\xd9\x27\x8a\x79\x18\x4c\x69\x68\x9a\xb0\xd4\x39\x9d\x1b\x13\xa8\x19\x70\x2e\x6c\xcc\x78\xd4\x9e\xb3\x6e\xb5\x0c\x33\x2d\xa5\xef\x49\xad\x0c\xc5\x60\x09\x03\x7b\x2c\x13\x2e\xb0\xda\x64\x33\x6c\xcc\x9c\x92\xbc\x53\xf9\x6e\x88\x75\x43\x52\xcc\x3b\x73\xb3\x62\x72\x76\x6f\xb3\xa3\x93\x35\x9c\xba\x2a\xaa\x2b\x42\xa2\x11\x00\x5b\x4a\xdd\x64\x84\x51\x98\xcd\x06\x6e\x66\x5f\x1a\xbe\x73\x1a\xce\x0b\x2a\x00\x62\xa9\x59\x68\x64\xb0\x9b\x2f\xbd\x63\x79\x71\x10\x00\xd8\x61\xb6\xe3\xa7\x9f\x1b\x80\xe9\x69\xcb\xdb\xc1\x89\xde\x05\x70\x69\x68\xa5\x0e\xd5\x86\x70\xd3\x16\x78\xab\x83\xdc\xc8\x1a\x79\xa2\x31\x40\x58\x60\x2a\x33\x7b\xb0\x38\x03\x31\xbf\x8f\x83\xdd\x03\xd7\xe5\x74\x3d\x80\x1f\x6a\x38\x19\x35\xf9\x50\xfd\x3f\x63\xb0\x0d\x0e\x1b\x35\x33\xe9\x48\xe8\x06\xef\xba\x90\x25\xd3\x0b\x1f\x2e\x0f\x11\x08\xa7\xed\xfe\x6a\x1c\xa2\x5c\x0b\x60\x19\x1b\x6f\xd0\xee\x84\x23\x3b\xbf\x36\x0e\xc9\x6d\x94\x3c\xe0\x57\x7d\x36\xbd\x05\xeb\x3b\x3a\x17\x0a\x73\xf6\x3c\xf6\x9d\x3f\x7a\x9b\xbb\x7f\x08\x40\x32\xe8\x14\x65\xcb\xa7\x05\x4e\xcb\xc5\xec\x51\x6d\x6b\x83\x93\x7e\xfd\xe7\x63\x4d\xe5\x62\x03\xf9\x8b\xe8\x60\x39\x6e\xc5\x3d\x8e\xe5\x4a\x40\xea\x93\x06\xf6\xc5\xd8\x28\x6b\x73\xb8\x6e\x30\xc9\xf5\x20\x26\xde\x20\x97\x9b\x88\x56\xd6\x5a\x77\x8f\x5d\x11\xf2\x34\x13\x7f\x48\x81\xe1\xdf\x7d\x3b\x0e\x21\xc2\x94\x2f\xe2\xb3\xd9\xe5\xa8\x6c\x81\x23\xfb\x50\x27\x67\x7d\xed\x3f\x9a\x07\x3f\x0d\x8b\xe4\x0f\x61\xe0\xc7\x87\x5a\xb3\x21\x54\x55\xad\x62\xc4\x23\x23\x00\xf2\x40\xfd\xe6\xd3\x27\x7b\xb9\x52\x90\xcc\xcb\x31\x91\x85\x55\x11\xf3\xf0\x00\x82\x9d\x7b\x03\xaa\x8a\x97\x46\xc1\x14\xea\x1c\x03\x97\xc7\x7e\xbb\xdd\x9e\x7e\x02\x7b\x8b\x2a\x80\x35\x33\xd7\x67\x1f\x29\x7f\x0a\x3d\x61\x79\x86\xee\xdf\x87\x98\xd0\x78\x64\x98\xeb\xb6\xb8\xd8\x3b\xa9\x67\x15\xca\xf6\xb9\x68\x0c\xbf\x4e\xee\x22\x3a\xdf\x86\x42\x76\x91\xd9\x06\xb1\x52\xc3\xcb\x20\x73\xa3\xba\x0f\xca\x37\xc0\x67\x81\xe2\xe4\xce\x85\x71\xc8\x5b\x52\x41\xab\xce\xcb\x14\xf9\xf3\xde\xf2\xd4\xae\x3d\x91\xea\xa0\x7a\xb7\xc3\x7c\x07\xd5\xaa\xa0\x14\x0f\x3d\x71\xc6\x7b\xce\xf3\xe7\xe5\x14\x92\x56\xdb\x7d\xf1\x55\x56\x28\xa2\x8a\x3e\xb2\xe0\xf5\x46\xd5\xb6\xdb\x4b\x69\x6d\x2b\x89\x9b\xb2\xaa\x28\xa2\x8b\x1d\x52\xf5\xb7\x6a\xb7\x2d\x30\xbd\x9f\x1c\xbc\x56\x8e\xe0\x5d\xc5\x78\xdd\xb3\xb1\x52\x66\x75\xcc\x3e\x80\x23\xc4\x3b\x00\x7b\x2f\x02\x57\x60\x40\x1b\xce\xec\x0c\xc9\xed\xca\xc1\x30\xec\x25\x09\x24\x8c\xc5\x09\xac\x38\x76\xde\x28\x0d\x00\x3b\x97\x05\x06\x77\x86\xa0\xd5\xc6\x15\x72\x95\x42\xb7\x04\x72\x5f\x45\x3c\x38\x41\x46\xc3\x52\x04\xef\x2e\x9e\xdf\xbb\x2b\x6d\xb9\x60\xf0\xd9\x0f\xd9\x63\x9b\xdb\xb1\x42\xcb\x00\xae\xe5\x17\x65\xdf\xed\x88\x03\xe4\xa9\xee\x76\x03\xc2\x0e\x9c\x8e\xea\xbe\x55\xd9\xbb\xaf\x4c\x3b\x00\xd6\x1b\xc8\xe7\x0d\x8e\xe4\x7a\x64\x86\x10\x90\x08\x67\xb0\x2c\x77\xc4\xdd\x8d\x61\x39\xf5\xbe\x58\xd1\x8e\xfc\xb9\x5a\xc4\x31\x5a\x91\xae\x8a\xab\xfb\xb4\x39\x36\xe6\xb7\xf7\x80\xf7\xd8\xe1\x05\x4b\x28\xc7\x42\xc8\x11\xdf\x47\x69\xc4\x9f\x44\xaf\x40\x13\x71\xb1\x8c\x3b\xfb\xbb\x9e\x77\xc3\x1a\x77\x13\xc2\xea\x5a\x1a\x19\x7e\xf0\x72\x21\xe1\x88\x18\x0e\x7e\x3b\x7b\x03\xb4\xba\x14\xf9\x17\x12\x42\x7c\xe7\xd8\x7f\x9a\x10\x43\xf4\x6c\x30\x03\x52\x94\xc8\x7d\xbc\x4f\xc2\x91\x6e\x24\x63\x6d\x7b\x79\xb9\x68\xdf\x12\xb7\x21\xac\x2d\x69\x44\xd5\xa8\x23\x14\x09\x17\xab\x48\xb0\x58\x20\x0c\x0d\x24\x27\x3e\xa9\xaa\xc0\x05\x21\x12\x1c\x49\xa5\xd5\xc2\x0a\x02\x2d\x92\x0c\xb3\xf3\xcb\x46\x36\xaa\x51\xc6\x60\xc8\x45\x8a\x99\x70\xa2\xfa\xcc\xd9\x36\x3a\x32\xe3\x54\x98\xc9\x0b\xa6\x3d\x40\x32\x3f\xc3\x6e\x9a\x5f\x4b\x69\x45\x95\x33\x01\xe7\xa8\x85\xbc\xcd\xe8\x72\xa0\xa1\x82\x40\x8d\x20\xe8\x8f\x31\xfb\x23\x74\x80\x5f\x1f\x0b\x59\xb8\xfd\x22\x86\xc0\x8a\x78\xae\x07\x7f\xc4\x80\x68\x84\x80\x48\x81\x11\x62\x41\x82\xc0\x24\x90\x8c\xcf\x1d\xf9\xe9\xb3\xd0\xc0\xb8\x7e\x07\x3a\xf6\xee\x01\x20\xfd\x4d\x9b\x5d\x8f\x0e\x44\x20\xb2\x43\x8f\xd7\xda\xeb\xb0\x2f\x46\x10\xb4\x91\x92\x40\x90\xf5\x7b\x80\xfa\xbf\x47\xc4\x34\x6f\xdd\x37\xa6\x7a\x35\x30\x7a\x58\x97\x2d\x62\xa9\xa2\xae\x35\x60\xf7\x1a\xbb\x8b\xe9\xca\x36\xbd\x5c\xac\x06\x6a\xe0\x6a\x68\xc9\x36\x54\x12\xd6\x1a\x08\x09\x96\xcf\x3f\xaa\xd8\xd9\x0c\xe4\x4d\x02\x75\x17\x5c\x0a\x56\x82\x9a\x17\x7e\x52\x92\xd7\x0a\x45\x90\x59\x68\x1e\x98\xb0\x7f\x28\x10\xc7\xc4\x07\x21\xe3\xdb\xc3\xb8\xfa\xf6\x2b\x19\x22\x90\x92\x1d\x15\x3c\x43\x03\x7c\x92\x42\x0c\x89\x0e\xf9\xcd\x1d\x09\xce\x76\x36\x8c\x96\xd1\xc8\x2e\x7d\x91\x10\xa1\x47\xb8\x6b\xd3\xf0\xb3\x7a\x90\x92\x34\x18\x3c\x13\xc3\x03\x1e\x47\x3c\xf7\xb8\x92\x3b\x06\x24\x08\x81\x12\x4a\x8d\x55\x21\x1a\x44\xbf\xa0\xf8\x76\xe3\x20\xfc\x8d\x85\x83\xf3\xa6\xf0\xc0\xdf\xe5\xb3\xc3\x4e\xf3\x2d\xc6\x88\x56\x67\xf0\xcf\x5e\xff\x1c\x7b\xcf\x0e\x50\x66\x1e\xaa\x2e\xc6\xc6\x92\x01\x19\x7d\xe9\xa0\x70\xed\x9b\x60\x67\xaf\xeb\xab\x5a\xd8\xda\x05\xdf\x63\x80\xf9\x6b\x5b\x9a\x8f\xe6\xe3\xac\xce\x37\x88\x9c\x26\x2b\x06\xb9\x38\x00\xe1\x02\x29\x14\x0b\xa9\xde\x6b\x36\x1c\x43\xef\xd6\x63\xba\x71\x27\xbe\xae\x2d\x96\x0e\xb3\x3d\xf9\x4e\xee\x4a\x9b\x0b\xf8\xc2\x49\x61\x0c\xdf\x12\x28\x0c\x22\xa3\xda\x15\x4a\x01\x6b\xcf\x7d\x9b\x79\x5b\xd1\xe7\xa9\xb1\xa4\x6b\x35\xd0\x45\xae\x23\x58\x59\x8d\x9b\x16\x59\x64\xaa\x54\xd9\x58\x0c\x50\xa9\x55\x48\x07\x98\xa6\x24\x15\x0d\x87\xaa\xd3\x65\x76\xf9\x6d\x03\xd7\xb8\x02\x97\xa6\xbe\xa4\x4d\xd1\x59\x21\x08\x07\x61\xb4\x0b\x11\x00\x79\x74\x7d\xc5\xf1\xc5\x51\x72\x1e\x00\x98\x3c\x87\x9f\x53\xf6\xc1\xee\xf5\xf4\xb2\x54\x1f\x81\xee\xe8\x84\x84\x81\x20\xcf\x64\x36\x7e\xc8\x6f\x72\x27\x76\xff\x1b\xee\xc7\xc8\x65\x90\x2c\x9d\x42\x5a\x16\x84\x3d\x1d\xa4\x52\x1c\xfb\xfe\x0c\x2a\x73\xf0\x7e\x2d\xa5\x8c\x8d\x4b\x21\xb1\x2c\xf1\x2a\x05\x54\xaf\x5c\x44\xc7\x8c\x16\xa3\xb8\xf9\x08\x6b\x09\xc2\x12\x7a\xeb\x66\x5a\xa3\x1b\x07\x32\x40\xf3\xab\xdd\xac\xf9\xa5\x92\xc4\x48\x4a\xcf\x90\xdf\x13\x4b\xd6\x37\x4c\xd2\x42\x5e\x48\xd3\xb4\xa9\xa6\xbb\xcd\x72\x99\x0b\x71\x5c\xcd\x1a\xe2\xc5\x72\xc3\x21\x81\x56\x8a\x0a\x8a\x63\x29\x98\x62\x22\x36\x52\xfd\x3f\x7c\xbd\x90\x24\xa8\xf9\xf6\xfb\x79\x61\xa0\x59\x0f\x20\xde\xa9\xb2\x65\x80\x53\xb6\xd8\x86\x3a\x52\x65\x32\x38\x74\xed\x77\xb6\xbd\x08\x18\xc3\xb3\x82\x05\x3c\xf8\x2e\xde\xea\xe3\x20\x5c\xb5\x86\xad\x41\x27\x43\x83\x36\x9b\x66\x31\x45\x7d\xec\x0e\x26\x0e\x93\x24\x8c\x97\x2b\x33\x12\xf2\x16\xb8\x84\x82\x1f\x50\x1b\x3b\x8a\x67\xc7\x92\x06\x8b\x0d\x3d\x5a\xd3\x11\xbd\xad\x0d\x23\xb2\xb5\x00\x72\x76\x1b\xf5\x2c\x42\x18\x68\x41\xd1\x4b\x06\x3a\x8c\xc1\xb2\xfc\x78\x44\xc5\xb8\x40\x8e\x5b\xca\x29\xe0\x48\x30\x24\x8e\xff\xd6\x58\xa1\xb2\xda\x16\x3a\x20\x4a\xa8\x81\xd3\x57\x29\xa0\xb3\x72\x9f\x54\xfa\x6f\xf3\x06\x6e\xe7\xbd\xd0\x6f\xbf\x3c\x47\x2d\x90\x09\x36\x03\xa4\x28\x86\x6a\x2a\x70\x2c\x86\xf0\x49\xa9\x88\x76\xda\x99\x6a\x32\x43\x5a\xc0\x98\x40\xa6\x24\xe8\x18\x25\xc3\xc2\xbb\x90\xcf\x2f\x11\x07\x3e\xde\x62\x98\xb8\xf8\xa0\x6d\xa3\x18\x00\x7e\x61\xb4\x0d\x41\x30\x6b\x09\x2f\x57\x84\xe6\x46\x48\xe5\x28\x3a\xc2\xd2\xfb\xb7\x74\xaa\x27\x56\xda\x6a\x76\x19\x8c\xdb\xa3\xa4\x47\x89\x9e\x64\xca\x9b\x04\x72\x1e\x69\x48\x47\x62\xe9\x53\x82\xf4\xa4\xc6\x43\x82\xcf\xb8\xe4\x85\xc3\x8b\x14\x73\x04\xb9\x04\xc1\x60\xaa\xa1\xa3\x6f\x1a\x12\xf1\x13\xda\xa8\x64\x57\x58\x81\x68\xc8\xa5\x88\x00\xc6\x0a\xe3\x1a\x41\x8c\x81\xdf\x56\x63\x09\xa2\x03\x09\x58\x70\x2e\x53\x6c\x35\x0d\x34\x2b\x16\x48\x56\x62\x06\xae\x07\xdd\x35\x3b\xd3\x37\xfd\x0e\x4d\x9c\x00\x62\xb2\x44\xd0\x2f\x54\xc1\xce\xfb\x9d\x93\x40\x39\x04\x2f\x05\xa8\x09\x19\x54\x95\x5e\x1d\x6d\xba\xde\x28\xd4\x54\x6c\x69\x9b\x6b\x6c\x6b\x5b\x59\xdc\x64\x24\x7f\x2d\x98\x07\x0c\x80\x9f\x13\x66\xac\xa5\x2d\x0b\x6d\x8d\x96\x5b\x68\xa8\x81\xd4\x80\xc0\xa7\x3a\x2b\xc4\xe2\x07\x9b\xb8\x1b\x83\x22\x41\xa8\xb7\x22\x04\x80\x83\xc8\x2b\x4f\x4a\x9b\xe2\x8b\x9c\x34\x8e\x83\x78\xd6\x57\xf0\xca\x1e\xfc\xc1\xc0\x90\x1e\xa0\x40\x87\x94\xa6\x18\x40\xc9\x7c\x20\x77\x6e\x5b\xa3\x23\x72\xe5\x08\x70\xd0\x97\x08\x87\xa4\xed\xcc\x76\xd5\x77\xd2\x7a\x21\xdc\x6c\x33\xc4\x49\x21\xaa\x0e\xda\x0e\x16\x28\x18\xda\xea\xd8\xb1\x17\x56\x48\x06\xd9\x56\xb8\xab\x6e\x6a\x68\xdb\x45\x16\x8b\x71\x6e\x06\xc2\x50\x85\x1e\x44\xac\x01\x68\x57\x90\xc4\xbc\x11\xd2\xe4\x48\x69\xb1\x2c\xb7\x54\x86\x9b\x49\xa4\x12\x48\xb1\xe7\xee\xed\xb3\x61\x91\x0e\x45\xcd\xfc\x06\x8f\x1b\xbc\x17\x59\xe5\xe6\xf0\x2f\x8a\x7e\xa0\x0f\x2c\x4b\x6a\x27\x4f\xb0\x2d\xb4\xf7\xc1\x43\x41\xf4\x70\xc2\x3a\xb7\xe2\x03\xfa\x1a\x1c\x16\xd5\xa3\x15\x7d\x39\x5b\x6a\xc2\x68\xa9\xae\xbc\xb0\x40\x1e\x07\xcd\xd1\x96\x44\xd9\x24\x02\x4e\x1f\x7e\xc2\xd3\xe4\x63\x85\xdc\xdd\x65\xce\x9c\x2f\xcc\xf9\xa7\x48\x19\x22\x43\xf7\x63\x6c\x2a\x84\x5f\xa4\x50\x6c\x70\x33\x03\x6d\x83\x09\xee\x44\x81\x52\x01\x46\xa0\x11\x89\x0f\xa8\x81\x59\x03\x91\x92\x7b\x8e\xd8\x62\x0b\x41\x2b\x2c\xf8\x50\xde\xa9\xa4\x20\xb5\x1d\xfb\xdb\x4f\x05\xfa\xbe\x76\xbe\xe8\xa0\x48\x3a\x51\x46\x08\x40\x41\x1a\xdd\x60\x2e\xa3\x1e\xb2\xf0\x4c\x7e\x5a\x0d\xf1\xa2\x68\xa0\x65\xc4\xa0\xc1\x06\xbe\xe7\xe0\xc0\x1c\x0d\xf8\x0a\x7d\xab\x69\x26\x51\x30\x78\x5e\x45\x90\x64\x24\x8f\x26\x8a\x06\xc1\x04\x22\x07\x87\xe6\x86\x84\x9a\x48\xb8\xc3\xe6\x93\x2d\x3d\xa7\x0f\xbf\xd1\x43\x6a\xfd\xd2\xdd\x09\xc4\x4d\xc3\xae\x37\xd6\xdf\xd5\x98\xc3\x07\x88\x1b\xb3\x72\x47\x84\x26\xe5\xef\x61\x73\x2c\x05\x3c\xa0\xef\xef\x29\xc4\x31\x2e\x51\x41\xb8\x6b\x9d\xd4\x27\x3a\x89\x63\x9e\x55\xb9\x1c\x7f\x58\x6f\xa3\xa6\x99\x1e\x7a\x0e\xa8\x6b\xda\x6e\xcf\x47\x50\x4e\x4e\xbf\x06\x58\x1e\x86\x6d\x63\xda\x04\xd8\x6b\x79\x0c\x8f\xcc\x25\x2a\x1e\x53\xe9\x41\x49\xce\x11\xa4\x23\x54\xa5\x1f\x50\xa2\xcb\xba\x86\xe5\xdc\x2c\xa1\x68\x21\x50\x00\x87\x00\x07\x7a\xe0\x5c\x65\x5f\x71\x8c\x6f\xf3\x62\xc9\x8b\x81\x47\xd5\xa0\xb2\xa6\x1e\x45\x91\x63\x78\x34\x7c\xdb\xe7\xd2\x01\xcd\xf7\x32\x77\x67\xaf\x75\x7c\xae\x89\x41\x21\x6e\x9a\x0a\x08\xb7\xca\xef\xe7\xf9\x8d\x88\x77\xd2\x8d\x55\x74\xf1\x2d\x27\x6b\x86\x5a\xdc\xb7\x37\xf9\x43\xda\x37\x4c\x08\x83\xfa\x28\xc9\xe3\xda\x49\xb6\xda\x82\xcb\xa1\x35\x18\x40\x90\x3c\x29\xbc\x90\xfc\xff\x53\xa0\xee\x03\x3d\x6c\x3c\x7e\x69\x1e\xb0\x97\x77\x1a\xc5\x54\x64\xa9\xa3\xd7\xce\x74\x96\x8c\x46\x8d\x1a\xb2\x15\x9a\x9d\x3a\x5c\x0c\x8d\x68\xca\x90\x5c\xb4\xc0\x60\x54\x5d\x32\xae\x9a\x4b\x20\x94\xd2\x58\x0b\x96\x98\x0c\xa3\x22\x30\xc8\x26\x87\x51\x80\x8b\x18\xc9\xa9\x04\xa3\x23\x58\x15\x0b\x6c\x28\xcb\x69\x5a\x94\xc1\x67\x28\x2a\x0d\x6d\x63\x6c\x5b\x18\xd7\x5e\xbc\xba\x30\x2e\x38\x68\x8d\xa7\x39\x71\x55\xb8\x4d\xab\x8d\x24\x44\x50\x8c\x19\x15\xfc\x81\x2e\x53\xdd\x1f\xc0\x95\x05\x58\x3b\x3e\x04\xe0\xdc\x49\xf3\x81\x93\x7a\x4f\xaf\x7f\x11\xea\xb8\xfd\x1d\x81\xab\x55\x53\x48\xc5\x08\x77\xe2\x83\x09\xf3\x86\x43\x44\xf6\x05\x92\x25\x8c\xba\x03\x03\x28\x05\x0b\x1b\x29\x0c\x81\x3a\xc6\x53\x12\xa0\xa4\x26\xda\x0c\x34\x24\x24\xc4\x30\x48\xa0\x1b\x61\xa6\x63\x0d\x41\x20\x5d\xd8\xb2\x65\xa8\xcf\xc8\x87\xd7\xf7\x60\x7a\x1b\x30\xf8\xf9\x1a\xe9\xf1\xb6\xfb\xc0\x6c\x9b\xcc\x2d\x60\xc5\x6d\x7c\x77\x1e\x89\x0b\x16\x78\x45\x5a\x60\x01\x08\x90\x42\x20\x6d\x31\x80\x5a\x31\x24\x43\x13\x5f\xaa\xbb\x05\xe0\x1b\x45\x1e\xd6\x83\x96\x76\x86\xb1\x05\xd1\x45\x4d\x4e\xe5\x77\x82\x6b\x0d\x0f\xd5\x26\xf3\xb7\x52\x88\x1a\xc8\x43\xb2\xea\x94\xdb\x27\x0a\xad\x07\x88\x9b\x64\x58\x44\x88\x5d\x00\x62\x35\x07\x7e\xe1\x35\x8e\xcd\xa8\xa1\x48\x58\x85\x18\x07\xa4\x0e\xbd\xe6\x86\xcc\x30\x32\x14\x39\x6f\x40\xe0\x06\xf5\x48\x14\x29\x5c\x54\x3f\x20\x4f\x8e\x89\x35\xb8\x0c\x08\xdb\x56\xdb\x18\xaa\x06\x31\x82\x32\x47\x07\x22\xc9\x40\x95\xdc\xa6\x1c\x84\x81\x3e\x13\xe2\x07\xa4\x08\xaa\x20\x0a\xa2\x8d\x18\xd1\x4d\x2b\x7b\x2d\xbb\x6f\x1b\xc6\xef\x70\x99\x09\x53\x2b\x56\x4b\x4e\x96\x45\xb6\x08\x03\x4b\x71\xc2\xd6\xb9\x65\x05\xb8\x58\x59\x39\x90\xe4\x0c\xf9\xc3\x6c\xa0\xba\xb9\xf2\xb6\xdb\x6d\xa7\xfd\xef\xe9\xad\x6e\xdb\x6d\xb6\xdb\x6d\xb6\xdb\x4b\x6b\x7c\xb7\x2d\xab\xbf\xc7\x5a\xd5\xb7\x64\x43\xbb\x62\xf0\x96\xe1\x74\xc0\x04\xd4\x47\xcf\x75\x2b\x2a\x75\xa4\x03\xed\x58\x15\x62\x43\x9f\x57\x52\x0c\x0c\x05\x6c\xc3\x29\x3e\xa4\x73\x2a\x8e\xbb\x6d\xcf\x68\xd4\x11\xca\xfc\x8c\x0f\x04\x4d\x07\x4a\x7d\x99\xe0\x01\x70\x4f\xa2\xf9\xbd\x8d\xc1\xbd\x9f\x27\x88\x1b\x48\x74\xa5\x10\xa0\x28\x17\x0f\x63\x27\x9f\x3e\xab\x40\x93\xb7\x08\x98\x3a\x93\x5e\x77\x59\x01\xd6\x20\x13\xe7\x21\xc0\xfe\x44\xae\x37\x0e\xee\x8c\x85\x60\xa5\x5d\x2b\x74\x9a\x72\xc9\xba\x91\xca\x04\xe8\x7d\x3b\x14\x88\x24\x4a\xae\xf1\x85\xb8\x60\xfb\xbe\x00\xe8\xf6\x01\x08\x6c\xba\xaa\x20\xc0\x91\x18\x21\xf7\xda\x3c\x16\xcf\x10\x69\xa1\x03\x30\x03\x02\xfa\x38\xda\xaa\xf6\x33\x44\xb4\x8d\x93\xae\x11\x03\x92\x50\xe0\x35\x1d\x06\x0d\x3b\x39\x29\xb6\x05\x25\x75\xc8\x13\x7a\x03\x96\x16\x86\xc1\x69\xb5\x6c\x5e\xa3\x72\x02\x58\x41\xa4\x06\x45\x46\x12\x47\x51\x3c\x9e\x24\xb4\xa2\x16\xcb\x22\xde\x00\xe7\x82\x0e\x4c\x5a\xe2\xb4\x05\xa3\x88\xba\x40\x19\x94\x90\x2d\x50\x7c\x8b\x38\xa1\x6c\x02\x64\x85\x86\xfd\xa1\x64\xc5\x2f\xb8\xe3\x02\xfb\xcb\x9b\x7b\x74\x7d\x75\xad\x61\x96\x63\x90\xc0\xa8\xc1\x09\x92\xa9\xe2\x14\x58\x20\x2d\x69\x41\x8f\xb0\x92\x9a\xba\xb6\x45\x59\x49\x17\x5b\x41\xa0\x25\x15\x19\xc2\x00\xc1\xc8\x50\xe1\x18\x30\x0f\x0f\x8f\xb9\x9a\xca\x8d\x59\x50\x0a\x22\x3b\x88\x18\x83\x26\xb1\x0a\xed\xbb\x6b\x26\x72\x19\xb5\x32\x01\x78\x05\xd6\x60\x99\xbb\x59\x9d\xb4\xbb\x2c\xe2\x22\x3c\x8b\x5d\x97\xe2\x9a\x93\x3a\xf5\x71\x91\xeb\x62\x72\xdb\x60\x95\x00\xa4\x83\x2a\x84\xa1\xf3\xca\x07\x65\x74\xe3\x00\x96\x42\xe9\x03\x9e\x39\xe3\xd4\xe2\x5d\xf3\x9d\x6c\xd6\x0a\x08\x45\x4a\x6e\xcd\x58\x66\x30\x45\x79\x31\x2f\x98\x94\x97\x8a\x54\x1e\x19\xea\xd9\x80\x71\x52\xa8\x82\xbb\xf9\x1d\xfd\xde\xae\xca\x6f\xee\xc1\x15\x59\xab\x34\xec\xd1\x4d\x8e\xb3\x33\x37\xac\x4c\x10\xbd\x8e\x5c\xa6\xf7\x03\x6b\x1a\x40\x1d\x56\x94\x3b\x8e\x09\x12\x07\xb6\x24\x80\x7c\x00\xd6\x47\xbf\x45\x93\xdb\xec\x0a\xb1\x7a\x0b\xe9\x4b\x19\x30\x1c\x81\x6f\x34\x2f\x34\xbc\x22\x79\x43\xac\xa5\x4d\x61\xb4\x0e\x44\x0e\x8b\x0a\x1a\x65\x45\x83\x8c\xb1\x4a\xc5\x2d\xa6\x39\x34\xb2\x92\xa6\xad\x46\x06\x5a\x8b\x04\x8a\x49\x52\x98\xce\xb0\xa9\xc2\x06\xd2\xe7\x10\x53\xd8\x16\x84\x2b\xc3\xe7\x5d\xf9\x9c\xcf\x26\x60\xa1\x83\x06\x4c\x68\x99\x32\xda\xaa\x57\x0c\xb9\x49\xe6\x5e\xe8\x5e\xf5\x28\x82\x97\x1e\xcf\x6d\x9a\x76\xf9\x91\x4c\xb8\x40\x86\x44\xd3\x5b\x02\xc5\xd0\x1b\x2a\xac\x22\x0c\x80\x32\x21\x08\x52\x86\x79\xa7\xa4\x0c\x56\x40\xf7\xf1\x67\x6c\xf8\x3a\x89\xca\x4d\xbc\xda\x47\xe6\x68\xa7\x99\x14\x26\x74\x42\x02\x26\xe8\xea\x22\x86\xf8\x09\x50\x59\x30\x81\x7c\xec\xc2\x05\x1e\xa7\x9c\x49\xfd\xbc\xfe\xd7\xc7\x42\xc1\xc8\x6a\x7b\x54\x83\x10\x01\xa4\xdb\xc0\xaf\x15\x4f\x92\x6c\x0e\x6a\x2a\x64\x60\x86\x35\x7f\x70\x22\xd2\x08\xcd\xdd\xa3\xc0\x1f\xc0\xf7\xbc\x12\x41\x67\x75\xa1\xf9\x79\x8f\xc5\xf0\x18\x5f\xae\x7b\x90\xda\x6f\xd7\xb9\xfc\x54\xdd\xc4\x3a\x1a\x02\xf3\xc3\x8b\x97\x1f\xd3\x37\x10\xf9\xcf\x28\x6a\x81\xd0\x5b\x70\x4b\x82\x78\x00\x1e\x30\x46\x40\x14\xb4\x50\x71\x33\x04\xb9\x83\xe3\xf4\xf6\xba\xee\x47\x20\x3b\x5c\xbb\x48\x7e\x33\x2e\x2f\x53\xbc\x0f\x69\xe8\xb4\xf6\x29\x9f\x47\xd4\xc0\x62\x1e\x49\xa0\x81\xd1\xa7\xc6\x29\x46\x56\x98\xef\x75\xb1\x00\xa2\x9c\x8c\x4c\xa0\x19\x3a\xd2\x12\x05\x0e\x42\x08\x58\x8e\xdb\xfb\x4d\xe3\xd7\x96\x12\xf0\xd6\x1f\xc4\x5a\x9b\x10\xfc\xf6\x21\x71\xa0\xa4\x7d\xbc\x7d\x93\xeb\x09\xd4\x4e\x42\x46\xa3\x8f\x50\xac\x5f\x72\xc5\x88\x91\xd7\x9b\x9f\x7f\x5b\xb7\x37\x9a\xbb\x73\x5e\x28\x1a\x12\x32\x60\x76\xd7\x73\x66\x06\xd1\x3b\x29\xd2\xcd\xd7\x1a\x31\x63\xcb\x60\x80\xa8\x2f\x3a\xe6\xb1\x88\x56\x28\xba\x06\xe1\x58\xb5\x14\x4f\x72\x27\xc1\x5e\xc8\xde\x08\x48\xbb\x7e\x0c\x47\xdf\x71\x98\xe8\x5d\x4f\x3c\x37\xf5\x7c\x97\x00\x39\x87\xa1\x02\x44\x84\x49\x02\x4d\xe7\x78\x3c\x20\x87\xf4\x17\xfa\x0b\xe8\x2f\xff\x8b\xb9\x22\x9c\x28\x48\x0a\xeb\x65\x8f\x80\x03\x00\x49\x7a\xe0\xbf\x73\x2c\x00\x00")
 
 func dataInvgroupsCsvBz2Bytes() ([]byte, error) {
 	return bindataRead(
@@ -182,9 +212,10 @@ func AssetNames() []string {
 
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
-	"data/filterStates.csv": dataFilterstatesCsv,
+	"data/columns.csv":           dataColumnsCsv,
+	"data/filterStates.csv":      dataFilterstatesCsv,
 	"data/invCategories.csv.bz2": dataInvcategoriesCsvBz2,
-	"data/invGroups.csv.bz2": dataInvgroupsCsvBz2,
+	"data/invGroups.csv.bz2":     dataInvgroupsCsvBz2,
 }
 
 // AssetDir returns the file names below a certain
@@ -226,11 +257,13 @@ type bintree struct {
 	Func     func() (*asset, error)
 	Children map[string]*bintree
 }
+
 var _bintree = &bintree{nil, map[string]*bintree{
 	"data": &bintree{nil, map[string]*bintree{
-		"filterStates.csv": &bintree{dataFilterstatesCsv, map[string]*bintree{}},
+		"columns.csv":           &bintree{dataColumnsCsv, map[string]*bintree{}},
+		"filterStates.csv":      &bintree{dataFilterstatesCsv, map[string]*bintree{}},
 		"invCategories.csv.bz2": &bintree{dataInvcategoriesCsvBz2, map[string]*bintree{}},
-		"invGroups.csv.bz2": &bintree{dataInvgroupsCsvBz2, map[string]*bintree{}},
+		"invGroups.csv.bz2":     &bintree{dataInvgroupsCsvBz2, map[string]*bintree{}},
 	}},
 }}
 
@@ -280,4 +313,3 @@ func _filePath(dir, name string) string {
 	cannonicalName := strings.Replace(name, "\\", "/", -1)
 	return filepath.Join(append([]string{dir}, strings.Split(cannonicalName, "/")...)...)
 }
-
@@ -0,0 +1,75 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overview
+
+import (
+	"sort"
+)
+
+// Canonicalise puts o into a canonical form, so that files exported by
+// different clients can be compared line by line. Preset groups and states,
+// and the background and flag states, are sorted and de-duplicated. Sections
+// and the attributes of presets and tabs are written in a fixed order, and
+// empty sections and preset attributes are written as empty lists rather than
+// left out. Ordered lists (e.g. flagOrder) are left as they are.
+func (o *Overview) Canonicalise() {
+	o.order = nil
+	for _, p := range o.Presets {
+		p.order = nil
+		if p.AlwaysShownStates == nil {
+			p.AlwaysShownStates = &PresetStates{Name: "alwaysShownStates"}
+		}
+		if p.FilteredStates == nil {
+			p.FilteredStates = &PresetStates{Name: "filteredStates"}
+		}
+		if p.Groups == nil {
+			p.Groups = &PresetGroups{}
+		}
+		p.AlwaysShownStates.States = canonicalStates(p.AlwaysShownStates.States)
+		p.FilteredStates.States = canonicalStates(p.FilteredStates.States)
+		p.Groups.Groups = canonicalGroups(p.Groups.Groups)
+	}
+	for _, ts := range o.TabSetup {
+		ts.order = nil
+	}
+	o.BackgroundStates = canonicalStates(o.BackgroundStates)
+	o.FlagStates = canonicalStates(o.FlagStates)
+}
+
+func canonicalStates(sts []StateType) []StateType {
+	out := []StateType{}
+	seen := make(map[StateType]bool)
+	for _, st := range sts {
+		if !seen[st] {
+			out = append(out, st)
+			seen[st] = true
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
+
+func canonicalGroups(igs []InvGroupId) []InvGroupId {
+	out := []InvGroupId{}
+	seen := make(map[InvGroupId]bool)
+	for _, ig := range igs {
+		if !seen[ig] {
+			out = append(out, ig)
+			seen[ig] = true
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
@@ -0,0 +1,117 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overview
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Column is an overview column identifier, e.g. "DISTANCE".
+type Column string
+
+func loadColumns(path string) (map[Column]string, error) {
+	reader, err := loadFile(path, columnPath)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to load overview columns CSV file: %v", err)
+	}
+	records, err := loadCsvEntries(reader, 2)
+	if err != nil {
+		return nil, err
+	}
+	m := make(map[Column]string, len(records))
+	for i, record := range records {
+		if i == 0 && record[0] == "columnID" {
+			// Skip the header line, if present.
+			continue
+		}
+		m[Column(strings.TrimSpace(record[0]))] = record[1]
+	}
+	return m, nil
+}
+
+// ColumnName returns the display name of the column, e.g. "Radial Velocity".
+func (c *Catalog) ColumnName(col Column) string {
+	s, ok := c.Columns[col]
+	if !ok {
+		return "Unknown Column"
+	}
+	return strings.TrimSpace(s)
+}
+
+func (d *decoder) columns(node *yaml.Node, path string) ([]Column, error) {
+	ss, err := d.strs(node, path)
+	cols := make([]Column, len(ss))
+	for i, s := range ss {
+		cols[i] = Column(s)
+	}
+	return cols, err
+}
+
+func (e *encoder) columns(cols []Column) []*yaml.Node {
+	out := make([]*yaml.Node, len(cols))
+	for i, col := range cols {
+		col := col
+		n := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: string(col)}
+		out[i] = e.annotate(n, func(a *annotator) string { return a.columnComment(col) })
+	}
+	return out
+}
+
+// checkUnknownColumns reports columns that aren't in the catalog.
+func checkUnknownColumns(o *Overview, c *Catalog, report Report) {
+	o.columnLists(func(section string, cols []Column) {
+		for i, col := range cols {
+			if _, ok := c.Columns[col]; !ok {
+				report(elemPath(section, i), "unknown column %+q", col)
+			}
+		}
+	})
+}
+
+// checkDuplicateColumns reports columns listed more than once in the same list.
+func checkDuplicateColumns(o *Overview, c *Catalog, report Report) {
+	o.columnLists(func(section string, cols []Column) {
+		seen := make(map[Column]bool)
+		for i, col := range cols {
+			if seen[col] {
+				report(elemPath(section, i), "duplicate column %+q", col)
+			}
+			seen[col] = true
+		}
+	})
+}
+
+// checkUnorderedColumns reports enabled columns that are missing from the
+// column order.
+func checkUnorderedColumns(o *Overview, c *Catalog, report Report) {
+	ordered := make(map[Column]bool)
+	for _, col := range o.ColumnOrder {
+		ordered[col] = true
+	}
+	for i, col := range o.OverviewColumns {
+		if !ordered[col] {
+			report(elemPath("overviewColumns", i), "enabled column %+q is missing from columnOrder", col)
+		}
+	}
+}
+
+// columnLists calls f with both lists of columns in o.
+func (o *Overview) columnLists(f func(section string, cols []Column)) {
+	f("columnOrder", o.ColumnOrder)
+	f("overviewColumns", o.OverviewColumns)
+}
@@ -0,0 +1,303 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overview
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// comments holds the comments found in a parsed file, keyed by the path of
+// the node they were attached to. List entries are identified by their
+// content rather than their index, and the value of a [name, value] pair by
+// its position in the pair (e.g. `.presets(pvp)[1](groups)[1]=25`), so the
+// comments stay with the right entry even if the model is reordered or
+// edited before being written out again.
+type comments map[string]*nodeComments
+
+type nodeComments struct {
+	head string
+	line string
+	foot string
+}
+
+func collectComments(doc *yaml.Node) comments {
+	cs := make(comments)
+	walkPaths(doc, "", func(path string, n *yaml.Node) {
+		if n.HeadComment == "" && n.LineComment == "" && n.FootComment == "" {
+			return
+		}
+		cs[path] = &nodeComments{head: n.HeadComment, line: n.LineComment, foot: n.FootComment}
+	})
+	return cs
+}
+
+// apply attaches the stored comments to the matching nodes in doc. Generated
+// annotations that were stored as part of a line comment are dropped, so they
+// can be replaced with fresh ones.
+func (cs comments) apply(doc *yaml.Node, e *encoder) {
+	if len(cs) == 0 {
+		return
+	}
+	walkPaths(doc, "", func(path string, n *yaml.Node) {
+		nc, ok := cs[path]
+		if !ok {
+			return
+		}
+		n.HeadComment = joinComments(humanHeadComment(nc.head), n.HeadComment)
+		n.FootComment = nc.foot
+		if n.LineComment == "" {
+			n.LineComment = nc.line
+			return
+		}
+		// The node already has a generated annotation, so only keep
+		// whatever was added to the old one by hand.
+		if human := humanComment(nc.line, e.generated(n)); human != "" {
+			n.LineComment += " " + human
+		}
+	})
+	moveListComments(doc)
+}
+
+// walkPaths calls f for every node under n, along with its path.
+func walkPaths(n *yaml.Node, path string, f func(string, *yaml.Node)) {
+	if n.Kind == yaml.DocumentNode {
+		// The document shares its path with its content, so give it its own
+		// key.
+		f(path+"^", n)
+		for _, c := range n.Content {
+			walkPaths(c, path, f)
+		}
+		return
+	}
+	walkNode(n, path, false, f)
+}
+
+// walkNode calls f for n and every node under it. pair is set if n is a
+// [name, value] pair, whose value is identified by its position rather than
+// its content, so its comments survive the value being edited.
+func walkNode(n *yaml.Node, path string, pair bool, f func(string, *yaml.Node)) {
+	f(path, n)
+	switch n.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			key := path + "." + n.Content[i].Value
+			walkNode(n.Content[i], key+":", false, f)
+			walkNode(n.Content[i+1], key, false, f)
+		}
+	case yaml.SequenceNode:
+		seen := make(map[string]int)
+		for i, c := range n.Content {
+			if pair && i > 0 {
+				walkNode(c, fmt.Sprintf("%s[%d]", path, i), false, f)
+				continue
+			}
+			elem := seqElemKey(c, i)
+			seen[elem]++
+			if seen[elem] > 1 {
+				// Keep duplicate entries distinct.
+				elem = fmt.Sprintf("%s~%d", elem, seen[elem])
+			}
+			walkNode(c, path+elem, !pair && isPair(c), f)
+		}
+	}
+}
+
+// seqElemKey identifies an entry in a sequence. Scalars are identified by
+// their value, and [name, value] pairs (which is how most of the overview is
+// structured) by their name.
+func seqElemKey(n *yaml.Node, i int) string {
+	switch {
+	case n.Kind == yaml.ScalarNode:
+		return "=" + scalarKey(n)
+	case isPair(n):
+		return "(" + scalarKey(n.Content[0]) + ")"
+	}
+	return fmt.Sprintf("[%d]", i)
+}
+
+func isPair(n *yaml.Node) bool {
+	return n.Kind == yaml.SequenceNode && len(n.Content) > 0 && n.Content[0].Kind == yaml.ScalarNode
+}
+
+// moveListComments moves line comments on the block sequence values of
+// [name, value] pairs to the name. A line comment after a flow sequence (e.g.
+// "- [11, 15] # note") is attached to the sequence, and would otherwise be
+// written above the pair when the sequence is written in block style.
+func moveListComments(n *yaml.Node) {
+	if isPair(n) && len(n.Content) == 2 {
+		name, val := n.Content[0], n.Content[1]
+		if val.Kind == yaml.SequenceNode && val.Style&yaml.FlowStyle == 0 && val.LineComment != "" {
+			name.LineComment = joinLine(name.LineComment, val.LineComment)
+			val.LineComment = ""
+		}
+	}
+	for _, c := range n.Content {
+		moveListComments(c)
+	}
+}
+
+func joinLine(a, b string) string {
+	if a == "" || b == "" {
+		return a + b
+	}
+	return a + " " + b
+}
+
+func scalarKey(n *yaml.Node) string {
+	if n.Tag == "!!null" {
+		return "null"
+	}
+	return n.Value
+}
+
+// humanHeadComment returns a head comment without any lines of a generated
+// description of a preset's groups, or recording the annotation templates,
+// which are replaced or dropped.
+func humanHeadComment(head string) string {
+	var lines []string
+	for _, line := range strings.Split(head, "\n") {
+		if !strings.HasPrefix(line, groupSpecPrefix) && !isTemplateDirective(line) {
+			lines = append(lines, line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// templates returns the annotation templates recorded at the top of the file,
+// if any.
+func (cs comments) templates() OutputOptions {
+	var opts OutputOptions
+	nc, ok := cs["^"]
+	if !ok {
+		return opts
+	}
+	for _, line := range strings.Split(nc.head, "\n") {
+		for _, td := range templateDirectives {
+			if strings.HasPrefix(line, td.prefix) {
+				*td.field(&opts) = strings.TrimPrefix(line, td.prefix)
+			}
+		}
+	}
+	return opts
+}
+
+func joinComments(a, b string) string {
+	if a == "" || b == "" {
+		return a + b
+	}
+	return a + "\n" + b
+}
+
+// humanComment returns the parts of a line comment that weren't generated by
+// a previous annotation run, given the annotations that could have been
+// generated for the node. A line comment of "# Ship (6) -- Frigate # note" on
+// group 25 has the generated "Ship (6) -- Frigate" removed, leaving "# note".
+// Anything else, even if it looks like an annotation, is kept.
+func humanComment(line string, generated []string) string {
+	text := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line), "#"))
+	for _, g := range generated {
+		switch {
+		case g == "":
+		case text == g:
+			return ""
+		case strings.HasPrefix(text, g+" #"):
+			return strings.TrimSpace(text[len(g):])
+		}
+	}
+	return line
+}
+
+// LostComments returns the comments in before that are missing from after,
+// ignoring the annotations that would be generated for before with opts. It
+// is used to check that a rewritten file kept everything that was added to it
+// by hand.
+func LostComments(before, after *Overview, c *Catalog, opts OutputOptions) ([]string, error) {
+	e, err := newEncoder(c, opts, before.comments)
+	if err != nil {
+		return nil, err
+	}
+	root, err := before.marshal(e)
+	if err != nil {
+		return nil, err
+	}
+	generated := make(map[string][]string)
+	walkPaths(&yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{root}}, "",
+		func(path string, n *yaml.Node) {
+			generated[path] = e.generated(n)
+		})
+	kept := make(map[string]int)
+	for _, path := range after.comments.paths() {
+		for _, s := range after.comments[path].texts(nil) {
+			kept[s]++
+		}
+	}
+	var lost []string
+	for _, path := range before.comments.paths() {
+		gen, ok := generated[path]
+		if !ok {
+			// A duplicate entry that was removed has the same annotation as
+			// the one that was kept.
+			gen = generated[dupSuffixRx.ReplaceAllString(path, "")]
+		}
+		for _, s := range before.comments[path].texts(gen) {
+			if kept[s] > 0 {
+				kept[s]--
+				continue
+			}
+			lost = append(lost, s)
+		}
+	}
+	return lost, nil
+}
+
+// dupSuffixRx matches the suffix that keeps the paths of duplicate list
+// entries distinct.
+var dupSuffixRx = regexp.MustCompile(`~\d+$`)
+
+func (cs comments) paths() []string {
+	paths := make([]string, 0, len(cs))
+	for path := range cs {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// texts returns the text of the comments that weren't generated, given the
+// annotations that could have been generated for the node. Each line, and
+// each "#"-separated part of a line comment, is returned separately, as
+// comments can be moved between nodes (see moveListComments) or merged with
+// annotations when written.
+func (nc *nodeComments) texts(generated []string) []string {
+	var out []string
+	for _, c := range []string{nc.head, nc.foot} {
+		for _, line := range strings.Split(humanHeadComment(c), "\n") {
+			if s := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line), "#")); s != "" {
+				out = append(out, s)
+			}
+		}
+	}
+	for _, s := range strings.Split(humanComment(nc.line, generated), "#") {
+		if s = strings.TrimSpace(s); s != "" {
+			out = append(out, s)
+		}
+	}
+	return out
+}
@@ -0,0 +1,298 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overview
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const commentsYAML = `presets:
+- - pvp
+  - - - groups
+      - - 26
+        # before 25
+        - 25
+        - 27 # note A
+    - - filteredStates
+      - [11, 15] # flow
+tabSetup:
+- - 0
+  - - - name
+      - PvP
+    - - overview
+      - pvp # tab ref
+`
+
+func testCatalog(t *testing.T) *Catalog {
+	t.Helper()
+	c, err := LoadCatalog(CatalogFiles{})
+	if err != nil {
+		t.Fatalf("LoadCatalog: %v", err)
+	}
+	return c
+}
+
+func marshalLF(t *testing.T, o *Overview, c *Catalog) string {
+	t.Helper()
+	opts := DefaultOutputOptions()
+	opts.CRLF = false
+	b, err := MarshalWithOptions(o, c, opts)
+	if err != nil {
+		t.Fatalf("MarshalWithOptions: %v", err)
+	}
+	return string(b)
+}
+
+// lineWith returns the line of out containing s, or "".
+func lineWith(out, s string) string {
+	for _, line := range strings.Split(out, "\n") {
+		if strings.Contains(line, s) {
+			return strings.TrimSpace(line)
+		}
+	}
+	return ""
+}
+
+// headComment parses out, and returns the head comment of the node whose
+// path ends in suffix.
+func headComment(t *testing.T, out, suffix string) string {
+	t.Helper()
+	o, err := Parse([]byte(out))
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	for path, nc := range o.comments {
+		if strings.HasSuffix(path, suffix) && nc.head != "" {
+			return nc.head
+		}
+	}
+	return ""
+}
+
+func TestCommentsSurviveEdits(t *testing.T) {
+	c := testCatalog(t)
+	tests := []struct {
+		name string
+		edit func(o *Overview)
+		// want are the lines that must be in the output.
+		want []string
+	}{
+		{
+			name: "unchanged",
+			edit: func(o *Overview) {},
+			want: []string{"- 27 # Ship (6) -- Battleship # note A"},
+		},
+		{
+			name: "reordered",
+			edit: func(o *Overview) { o.Canonicalise() },
+			want: []string{"- 27 # Ship (6) -- Battleship # note A"},
+		},
+		{
+			name: "first entry replaced",
+			edit: func(o *Overview) { o.Presets[0].Groups.Groups = []InvGroupId{28, 25, 27} },
+			want: []string{"- 27 # Ship (6) -- Battleship # note A"},
+		},
+		{
+			name: "flow list",
+			edit: func(o *Overview) {},
+			want: []string{"- - filteredStates # flow"},
+		},
+		{
+			name: "flow list reordered",
+			edit: func(o *Overview) { o.Canonicalise() },
+			want: []string{"- - filteredStates # flow"},
+		},
+		{
+			name: "preset renamed",
+			edit: func(o *Overview) {
+				if _, err := o.RenamePreset("pvp", "PvP main"); err != nil {
+					t.Fatalf("RenamePreset: %v", err)
+				}
+			},
+			want: []string{"- PvP main # tab ref", "- 27 # Ship (6) -- Battleship # note A"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			o, err := Parse([]byte(commentsYAML))
+			if err != nil {
+				t.Fatalf("Parse: %v", err)
+			}
+			test.edit(o)
+			out := marshalLF(t, o, c)
+			for _, want := range test.want {
+				if lineWith(out, want) != want {
+					t.Errorf("missing line %q in output:\n%s", want, out)
+				}
+			}
+			if head := headComment(t, out, "=25"); head != "# before 25" {
+				t.Errorf("group 25 has head comment %q, want %q:\n%s", head, "# before 25", out)
+			}
+			if strings.Contains(out, "- # flow") {
+				t.Errorf("flow list comment moved off its entry:\n%s", out)
+			}
+		})
+	}
+}
+
+func TestCommentsRoundTrip(t *testing.T) {
+	c := testCatalog(t)
+	o, err := Parse([]byte(commentsYAML))
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	o.Canonicalise()
+	first := marshalLF(t, o, c)
+	o, err = Parse([]byte(first))
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	o.Canonicalise()
+	if second := marshalLF(t, o, c); second != first {
+		t.Errorf("output changed on a second pass:\n%s\nthen:\n%s", first, second)
+	}
+}
+
+func TestAnnotationLikeComments(t *testing.T) {
+	c := testCatalog(t)
+	in := `presets:
+- - pvp
+  - - - groups
+      - - 25 # Unknown why Bob wanted this
+        - 26 # Fozzie (2024) -- keep
+        - 27 # Pilot is in your fleet
+        - 28 # Ship (6) -- Frigate
+    - - filteredStates
+      - - 11 # Ship (6) -- Frigate
+`
+	want := []string{
+		"- - 25 # Ship (6) -- Frigate # Unknown why Bob wanted this",
+		"- 26 # Ship (6) -- Cruiser # Fozzie (2024) -- keep",
+		"- 27 # Ship (6) -- Battleship # Pilot is in your fleet",
+		// Only the annotation of the node's own ID is generated.
+		"- 28 # Ship (6) -- Industrial # Ship (6) -- Frigate",
+		"- - 11 # Pilot is in your fleet # Ship (6) -- Frigate",
+	}
+	out := in
+	for pass := 1; pass <= 2; pass++ {
+		o, err := Parse([]byte(out))
+		if err != nil {
+			t.Fatalf("Parse: %v", err)
+		}
+		out = marshalLF(t, o, c)
+		for _, w := range want {
+			if lineWith(out, w) != w {
+				t.Errorf("pass %d: missing line %q in output:\n%s", pass, w, out)
+			}
+		}
+	}
+}
+
+func TestChangedTemplates(t *testing.T) {
+	c := testCatalog(t)
+	custom := DefaultOutputOptions()
+	custom.CRLF = false
+	custom.GroupComment = "{{.Name}}"
+	custom.StateComment = "state {{.ID}}: {{.Name}}"
+	other := DefaultOutputOptions()
+	other.CRLF = false
+	other.GroupComment = "g{{.ID}}"
+	def := DefaultOutputOptions()
+	def.CRLF = false
+	tests := []struct {
+		opts OutputOptions
+		want []string
+	}{
+		{custom, []string{"- - 25 # Frigate # note", "- - 11 # state 11: Pilot is in your fleet"}},
+		{def, []string{"- - 25 # Ship (6) -- Frigate # note", "- - 11 # Pilot is in your fleet"}},
+		{custom, []string{"- - 25 # Frigate # note", "- - 11 # state 11: Pilot is in your fleet"}},
+		{other, []string{"- - 25 # g25 # note", "- - 11 # Pilot is in your fleet"}},
+		{custom, []string{"- - 25 # Frigate # note", "- - 11 # state 11: Pilot is in your fleet"}},
+	}
+	out := `presets:
+- - pvp
+  - - - groups
+      - - 25 # note
+    - - filteredStates
+      - - 11
+`
+	for i, test := range tests {
+		o, err := Parse([]byte(out))
+		if err != nil {
+			t.Fatalf("Parse: %v", err)
+		}
+		b, err := MarshalWithOptions(o, c, test.opts)
+		if err != nil {
+			t.Fatalf("MarshalWithOptions: %v", err)
+		}
+		out = string(b)
+		for _, w := range test.want {
+			if lineWith(out, w) != w {
+				t.Errorf("pass %d: missing line %q in output:\n%s", i+1, w, out)
+			}
+		}
+	}
+}
+
+func TestLostComments(t *testing.T) {
+	c := testCatalog(t)
+	tests := []struct {
+		name string
+		in   string
+		want []string
+	}{
+		{"annotated", commentsYAML, nil},
+		{"duplicate dropped", `presets:
+- - pvp
+  - - - groups
+      - - 25 # first
+        - 25 # second
+`, []string{"second"}},
+		{"annotated duplicate dropped", `presets:
+- - pvp
+  - - - groups
+      - - 25 # Ship (6) -- Frigate
+        - 25 # Ship (6) -- Frigate
+`, nil},
+		{"annotation-like duplicate dropped", `presets:
+- - pvp
+  - - - groups
+      - - 25
+        - 25 # Fozzie (2024) -- keep
+`, []string{"Fozzie (2024) -- keep"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			before, err := Parse([]byte(tc.in))
+			if err != nil {
+				t.Fatalf("Parse: %v", err)
+			}
+			before.Canonicalise()
+			after, err := Parse([]byte(marshalLF(t, before, c)))
+			if err != nil {
+				t.Fatalf("Parse: %v", err)
+			}
+			got, err := LostComments(before, after, c, DefaultOutputOptions())
+			if err != nil {
+				t.Fatalf("LostComments: %v", err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("LostComments = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overview
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// RawAttr is a named value that this package doesn't understand. The value
+// is kept in its original yaml form, so it can be written back out unchanged.
+type RawAttr struct {
+	Name  string
+	Value *yaml.Node
+	// path is where the value was found in the parsed file.
+	path string
+}
+
+func (ra RawAttr) unrecognised(file, desc string) *Diagnostic {
+	return &Diagnostic{
+		Severity: SevWarning,
+		Pos:      Position{File: file, Line: ra.Value.Line, Column: ra.Value.Column},
+		Path:     ra.path,
+		Message:  fmt.Sprintf("unrecognised %s %+q, passing through unchanged", desc, ra.Name),
+	}
+}
+
+// attrOrder returns the order in which attributes should be written out: the
+// order they were seen in when parsing, followed by any canonical or extra
+// attributes that weren't seen.
+func attrOrder(seen, canonical []string, extra []RawAttr) []string {
+	var out []string
+	for _, name := range seen {
+		if !isKnown(name, out) {
+			out = append(out, name)
+		}
+	}
+	for _, name := range canonical {
+		if !isKnown(name, out) {
+			out = append(out, name)
+		}
+	}
+	for _, ra := range extra {
+		if !isKnown(ra.Name, out) {
+			out = append(out, ra.Name)
+		}
+	}
+	return out
+}
+
+func isKnown(name string, names []string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+func resolveAlias(n *yaml.Node) *yaml.Node {
+	for n.Kind == yaml.AliasNode && n.Alias != nil {
+		n = n.Alias
+	}
+	return n
+}
@@ -0,0 +1,136 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overview
+
+import (
+	"fmt"
+	"sort"
+)
+
+// PresetSet is the groups and states of a preset, or a list of groups, as
+// combined by the preset set operations.
+type PresetSet struct {
+	Groups      []InvGroupId
+	AlwaysShown []StateType
+	Filtered    []StateType
+	// HasStates is false for lists of groups, which don't take part in the
+	// set operations on states.
+	HasStates bool
+}
+
+// PresetSetOf returns the groups and states of p.
+func PresetSetOf(p *Preset) *PresetSet {
+	return &PresetSet{Groups: p.groups(), AlwaysShown: p.alwaysShown(), Filtered: p.filtered(),
+		HasStates: true}
+}
+
+// GroupSet returns a set of just groups, e.g. from a group list in groups/.
+func GroupSet(igs []InvGroupId) *PresetSet {
+	return &PresetSet{Groups: igs}
+}
+
+// Union returns the groups and states in either a or b.
+func (a *PresetSet) Union(b *PresetSet) *PresetSet {
+	return a.combine(b, func(inA, inB bool) bool { return inA || inB })
+}
+
+// Intersect returns the groups and states in both a and b.
+func (a *PresetSet) Intersect(b *PresetSet) *PresetSet {
+	return a.combine(b, func(inA, inB bool) bool { return inA && inB })
+}
+
+// Difference returns the groups and states in a but not b.
+func (a *PresetSet) Difference(b *PresetSet) *PresetSet {
+	return a.combine(b, func(inA, inB bool) bool { return inA && !inB })
+}
+
+// combine applies a set operation, given as whether an entry is kept based
+// on whether it's in a and b. States are only combined if both sets have
+// them, otherwise they are taken from whichever set does.
+func (a *PresetSet) combine(b *PresetSet, keep func(inA, inB bool) bool) *PresetSet {
+	out := &PresetSet{HasStates: a.HasStates || b.HasStates}
+	out.Groups = combineGroups(a.Groups, b.Groups, keep)
+	switch {
+	case a.HasStates && b.HasStates:
+		out.AlwaysShown = combineStates(a.AlwaysShown, b.AlwaysShown, keep)
+		out.Filtered = combineStates(a.Filtered, b.Filtered, keep)
+	case a.HasStates:
+		out.AlwaysShown, out.Filtered = a.AlwaysShown, a.Filtered
+	case b.HasStates:
+		out.AlwaysShown, out.Filtered = b.AlwaysShown, b.Filtered
+	}
+	return out
+}
+
+func combineGroups(a, b []InvGroupId, keep func(inA, inB bool) bool) []InvGroupId {
+	inA, inB := make(map[InvGroupId]bool), make(map[InvGroupId]bool)
+	for _, ig := range a {
+		inA[ig] = true
+	}
+	for _, ig := range b {
+		inB[ig] = true
+	}
+	out := []InvGroupId{}
+	seen := make(map[InvGroupId]bool)
+	for _, igs := range [][]InvGroupId{a, b} {
+		for _, ig := range igs {
+			if keep(inA[ig], inB[ig]) && !seen[ig] {
+				out = append(out, ig)
+				seen[ig] = true
+			}
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
+
+func combineStates(a, b []StateType, keep func(inA, inB bool) bool) []StateType {
+	inA, inB := make(map[StateType]bool), make(map[StateType]bool)
+	for _, st := range a {
+		inA[st] = true
+	}
+	for _, st := range b {
+		inB[st] = true
+	}
+	out := []StateType{}
+	seen := make(map[StateType]bool)
+	for _, sts := range [][]StateType{a, b} {
+		for _, st := range sts {
+			if keep(inA[st], inB[st]) && !seen[st] {
+				out = append(out, st)
+				seen[st] = true
+			}
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
+
+// SetPreset sets the groups and states of the named preset to ps, adding the
+// preset if it doesn't exist. If it does exist, replace must be set.
+func (o *Overview) SetPreset(name string, ps *PresetSet, replace bool) error {
+	p := o.Preset(name)
+	if p != nil && !replace {
+		return fmt.Errorf("preset %+q already exists", name)
+	}
+	if p == nil {
+		p = &Preset{Name: name}
+		o.Presets = append(o.Presets, p)
+	}
+	p.Groups = &PresetGroups{Groups: ps.Groups}
+	p.AlwaysShownStates = &PresetStates{Name: "alwaysShownStates", States: ps.AlwaysShown}
+	p.FilteredStates = &PresetStates{Name: "filteredStates", States: ps.Filtered}
+	return nil
+}
@@ -12,13 +12,12 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package main
+package overview
 
 import (
 	"bytes"
 	"compress/bzip2"
 	"encoding/csv"
-	"flag"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -32,12 +31,105 @@ const (
 	invCatPath      = "data/invCategories.csv"
 	invCatPathUrl   = "https://www.fuzzwork.co.uk/dump/latest/invCategories.csv.bz2"
 	filterStatePath = "data/filterStates.csv"
+	columnPath      = "data/columns.csv"
 )
 
-var catFile = flag.String("categories", "",
-	fmt.Sprintf("Use external inventory categories CSV file."))
-var groupsFile = flag.String("groups", "", fmt.Sprintf("Use external inventory groups CSV file."))
-var stateFile = flag.String("states", "", "Use external filter states CSV file")
+// Catalog holds the static Eve data needed to describe the IDs used in an
+// overview.
+type Catalog struct {
+	Categories map[InvCategoryId]string
+	Groups     map[InvGroupId]*InvGroup
+	States     map[StateType]string
+	Columns    map[Column]string
+	// Replacements are the groups that replaced retired ones.
+	Replacements GroupReplacements
+	// Types are the inventory types, if a types file was given.
+	Types map[InvTypeId]*InvType
+}
+
+// CatalogFiles lists external CSV files to load the catalog from. Any that
+// are empty are loaded from the copy embedded in the package instead.
+type CatalogFiles struct {
+	Categories string
+	Groups     string
+	States     string
+	Columns    string
+	// Replacements is the group replacements CSV file. There is no embedded
+	// copy, so no groups have replacements if it's empty.
+	Replacements string
+	// Types is the inventory types CSV file. Types aren't embedded, so none
+	// are loaded if it's empty.
+	Types string
+}
+
+// LoadCatalog loads the inventory categories, inventory groups, filter states,
+// overview columns, group replacements and, if given, inventory types.
+func LoadCatalog(files CatalogFiles) (*Catalog, error) {
+	var err error
+	c := &Catalog{}
+	if c.Categories, err = loadCategories(files.Categories); err != nil {
+		return nil, err
+	}
+	if c.Groups, err = loadGroups(files.Groups); err != nil {
+		return nil, err
+	}
+	if c.States, err = loadStates(files.States); err != nil {
+		return nil, err
+	}
+	if c.Columns, err = loadColumns(files.Columns); err != nil {
+		return nil, err
+	}
+	if c.Replacements, err = loadReplacements(files.Replacements); err != nil {
+		return nil, err
+	}
+	if c.Types, err = loadTypes(files.Types); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// CategoryName returns the name of the inventory category, e.g. "Ship".
+func (c *Catalog) CategoryName(ic InvCategoryId) string {
+	s, ok := c.Categories[ic]
+	if !ok {
+		return "Unknown InvCategory"
+	}
+	return strings.TrimSpace(s)
+}
+
+// Category returns the name and ID of the inventory category, e.g. "Ship (6)".
+func (c *Catalog) Category(ic InvCategoryId) string {
+	return fmt.Sprintf("%s (%d)", c.CategoryName(ic), int(ic))
+}
+
+// GroupName returns the category and name of the inventory group, e.g.
+// "Ship (6) -- Frigate".
+func (c *Catalog) GroupName(ig InvGroupId) string {
+	g, ok := c.Groups[ig]
+	if !ok {
+		return "Unknown InvGroup"
+	}
+	return fmt.Sprintf("%s -- %s", c.Category(g.Cat), strings.TrimSpace(g.Name))
+}
+
+// Group returns the description of the inventory group including its ID.
+func (c *Catalog) Group(ig InvGroupId) string {
+	return fmt.Sprintf("%s (%d)", c.GroupName(ig), int(ig))
+}
+
+// StateName returns the description of the filter state.
+func (c *Catalog) StateName(st StateType) string {
+	s, ok := c.States[st]
+	if !ok {
+		return "Unknown StateType"
+	}
+	return strings.TrimSpace(s)
+}
+
+// State returns the description of the filter state including its ID.
+func (c *Catalog) State(st StateType) string {
+	return fmt.Sprintf("%s (%d)", c.StateName(st), int(st))
+}
 
 type readerCloser struct {
 	*bytes.Reader
@@ -74,20 +166,8 @@ func loadFile(path, assetPath string) (io.Reader, error) {
 
 type InvCategoryId int
 
-func (ic InvCategoryId) name() string {
-	s, ok := invCategories[ic]
-	if !ok {
-		return "Unknown InvCategory"
-	}
-	return strings.TrimSpace(s)
-}
-
-func (ic InvCategoryId) String() string {
-	return fmt.Sprintf("%s (%d)", ic.name(), int(ic))
-}
-
-func loadCategories() (map[InvCategoryId]string, error) {
-	reader, err := loadFile(*catFile, invCatPath)
+func loadCategories(path string) (map[InvCategoryId]string, error) {
+	reader, err := loadFile(path, invCatPath)
 	if err != nil {
 		return nil, fmt.Errorf("Unable to load inventory categories CSV file: %v", err)
 	}
@@ -112,30 +192,16 @@ func loadCategories() (map[InvCategoryId]string, error) {
 
 type InvGroupId int
 
-func (ig InvGroupId) name() string {
-	g, ok := invGroups[ig]
-	if !ok {
-		return "Unknown InvGroup"
-	}
-	return fmt.Sprintf("%s -- %s", g.Cat, strings.TrimSpace(g.Name))
-}
-
-func (ig InvGroupId) String() string {
-	return fmt.Sprintf("%s (%d)", ig.name(), int(ig))
-}
-
-func (ig InvGroupId) MarshalYAML() (interface{}, error) {
-	return fmt.Sprintf("%d %s %s", int(ig), commentMarker, ig.name()), nil
-}
-
 type InvGroup struct {
 	Id   InvGroupId
 	Cat  InvCategoryId
 	Name string
+	// Published is true if the group is published in the SDE.
+	Published bool
 }
 
-func loadGroups() (map[InvGroupId]*InvGroup, error) {
-	reader, err := loadFile(*groupsFile, invGroupPath)
+func loadGroups(path string) (map[InvGroupId]*InvGroup, error) {
+	reader, err := loadFile(path, invGroupPath)
 	if err != nil {
 		return nil, fmt.Errorf("Unable to load inventory groups CSV file: %v", err)
 	}
@@ -157,14 +223,15 @@ func loadGroups() (map[InvGroupId]*InvGroup, error) {
 		if err != nil {
 			return nil, err
 		}
-		g := &InvGroup{Id: InvGroupId(id), Cat: InvCategoryId(catId), Name: record[2]}
+		g := &InvGroup{Id: InvGroupId(id), Cat: InvCategoryId(catId), Name: record[2],
+			Published: record[8] == "1"}
 		m[g.Id] = g
 	}
 	return m, nil
 }
 
-func loadStates() (map[StateType]string, error) {
-	reader, err := loadFile(*stateFile, filterStatePath)
+func loadStates(path string) (map[StateType]string, error) {
+	reader, err := loadFile(path, filterStatePath)
 	if err != nil {
 		return nil, fmt.Errorf("Unable to load filter states CSV file: %v", err)
 	}
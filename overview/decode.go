@@ -0,0 +1,240 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overview
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"gopkg.in/yaml.v3"
+)
+
+// decoder builds the model from a parsed yaml node tree. Every error it
+// returns is a *Diagnostic that points at the offending node.
+type decoder struct {
+	file string
+	// keepGoing causes errors in list entries and sections to be recorded in
+	// diags, and the offending entry skipped, rather than aborting.
+	keepGoing bool
+	// strict makes unknown sections and attributes errors, instead of keeping
+	// them as RawAttrs.
+	strict bool
+	diags  Diagnostics
+	// positions records where each element was found, keyed by path.
+	positions map[string]Position
+}
+
+// mark records the position of the element at path.
+func (d *decoder) mark(n *yaml.Node, path string) {
+	if d.positions == nil {
+		d.positions = make(map[string]Position)
+	}
+	d.positions[path] = d.pos(n)
+}
+
+// unknown handles a section or attribute that isn't recognised. In strict
+// mode it returns an error, otherwise the value is appended to extra.
+func (d *decoder) unknown(extra *[]RawAttr, desc, name string, n *yaml.Node, path string) error {
+	if d.strict {
+		return d.errorf(n, path, "unknown %s %+q", desc, name)
+	}
+	*extra = append(*extra, RawAttr{Name: name, Value: n, path: path})
+	return nil
+}
+
+// recover records err and returns true if the decoder is collecting errors.
+// Otherwise it returns false, and the caller should return err.
+func (d *decoder) recover(err error) bool {
+	if !d.keepGoing {
+		return false
+	}
+	diag, ok := err.(*Diagnostic)
+	if !ok {
+		diag = &Diagnostic{Severity: SevError, Pos: Position{File: d.file}, Message: err.Error()}
+	}
+	d.diags = append(d.diags, diag)
+	return true
+}
+
+func (d *decoder) pos(n *yaml.Node) Position {
+	return Position{File: d.file, Line: n.Line, Column: n.Column}
+}
+
+func (d *decoder) errorf(n *yaml.Node, path string, format string, a ...interface{}) error {
+	return &Diagnostic{
+		Severity: SevError, Pos: d.pos(n), Path: path, Message: fmt.Sprintf(format, a...),
+	}
+}
+
+// yamlErrRx matches the line number in the errors from the yaml parser.
+var yamlErrRx = regexp.MustCompile(`^yaml: line (\d+): (.*)$`)
+
+// syntaxError converts an error from the yaml parser into a Diagnostic.
+func (d *decoder) syntaxError(err error) error {
+	diag := &Diagnostic{Severity: SevError, Pos: Position{File: d.file}, Message: err.Error()}
+	if m := yamlErrRx.FindStringSubmatch(err.Error()); m != nil {
+		diag.Pos.Line, _ = strconv.Atoi(m[1])
+		diag.Message = m[2]
+	}
+	return diag
+}
+
+func elemPath(path string, i int) string {
+	return fmt.Sprintf("%s[%d]", path, i)
+}
+
+// seq returns the entries of a sequence node. If n > 0, the sequence must have
+// exactly n entries. A null is treated as an empty sequence.
+func (d *decoder) seq(node *yaml.Node, path string, n int) ([]*yaml.Node, error) {
+	node = resolveAlias(node)
+	if node.Kind == yaml.ScalarNode && node.ShortTag() == "!!null" && n == 0 {
+		return nil, nil
+	}
+	if node.Kind != yaml.SequenceNode {
+		return nil, d.errorf(node, path, "not a list (%s)", kindName(node))
+	}
+	if n > 0 && len(node.Content) != n {
+		return nil, d.errorf(node, path, "wrong number of entries (Expected: %d Got: %d)",
+			n, len(node.Content))
+	}
+	return node.Content, nil
+}
+
+// list calls f for every entry of a sequence node.
+func (d *decoder) list(node *yaml.Node, path string, f func(*yaml.Node, string) error) error {
+	entries, err := d.seq(node, path, 0)
+	if err != nil {
+		return err
+	}
+	for i, entry := range entries {
+		d.mark(entry, elemPath(path, i))
+		if err := f(entry, elemPath(path, i)); err != nil && !d.recover(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// pair decodes a [name, value] pair, which is how most of the overview is
+// structured.
+func (d *decoder) pair(node *yaml.Node, path string) (string, *yaml.Node, error) {
+	entries, err := d.seq(node, path, 2)
+	if err != nil {
+		return "", nil, err
+	}
+	name, err := d.str(entries[0], path)
+	if err != nil {
+		return "", nil, err
+	}
+	return name, entries[1], nil
+}
+
+func (d *decoder) scalar(node *yaml.Node, path string, tag string) (*yaml.Node, error) {
+	node = resolveAlias(node)
+	if node.Kind != yaml.ScalarNode {
+		return nil, d.errorf(node, path, "type is not %s (%s)", tag[2:], kindName(node))
+	}
+	if node.ShortTag() != tag {
+		return nil, d.errorf(node, path, "type is not %s (%s): %+q",
+			tag[2:], kindName(node), node.Value)
+	}
+	return node, nil
+}
+
+func (d *decoder) str(node *yaml.Node, path string) (string, error) {
+	if n := resolveAlias(node); n.Kind == yaml.ScalarNode && n.ShortTag() == "!!null" {
+		// Handle the case when the config contains `null` instead of empty string.
+		return "", nil
+	}
+	node, err := d.scalar(node, path, "!!str")
+	if err != nil {
+		return "", err
+	}
+	return node.Value, nil
+}
+
+func (d *decoder) integer(node *yaml.Node, path string) (int, error) {
+	node, err := d.scalar(node, path, "!!int")
+	if err != nil {
+		return -1, err
+	}
+	var n int
+	if err := node.Decode(&n); err != nil {
+		return -1, d.errorf(node, path, "%v", err)
+	}
+	return n, nil
+}
+
+func (d *decoder) boolean(node *yaml.Node, path string) (bool, error) {
+	node, err := d.scalar(node, path, "!!bool")
+	if err != nil {
+		return false, err
+	}
+	var b bool
+	if err := node.Decode(&b); err != nil {
+		return false, d.errorf(node, path, "%v", err)
+	}
+	return b, nil
+}
+
+func (d *decoder) ints(node *yaml.Node, path string) ([]int, error) {
+	var ns []int
+	err := d.list(node, path, func(entry *yaml.Node, path string) error {
+		n, err := d.integer(entry, path)
+		if err != nil {
+			return err
+		}
+		ns = append(ns, n)
+		return nil
+	})
+	return ns, err
+}
+
+func (d *decoder) strs(node *yaml.Node, path string) ([]string, error) {
+	var ss []string
+	err := d.list(node, path, func(entry *yaml.Node, path string) error {
+		s, err := d.str(entry, path)
+		if err != nil {
+			return err
+		}
+		ss = append(ss, s)
+		return nil
+	})
+	return ss, err
+}
+
+func (d *decoder) states(node *yaml.Node, path string) ([]StateType, error) {
+	ns, err := d.ints(node, path)
+	sts := make([]StateType, len(ns))
+	for i, n := range ns {
+		sts[i] = StateType(n)
+	}
+	return sts, err
+}
+
+func kindName(n *yaml.Node) string {
+	switch n.Kind {
+	case yaml.DocumentNode:
+		return "document"
+	case yaml.SequenceNode:
+		return "list"
+	case yaml.MappingNode:
+		return "mapping"
+	case yaml.AliasNode:
+		return "alias"
+	}
+	return n.ShortTag()
+}
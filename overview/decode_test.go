@@ -0,0 +1,133 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overview
+
+import (
+	"reflect"
+	"testing"
+)
+
+const decodeYAML = `presets:
+- - pvp
+  - - - groups
+      - [25]
+- - bad
+  - - - groups
+      - [oops]
+- 12
+flagOrder: [1, two]
+`
+
+func diagStrings(ds Diagnostics) []string {
+	var out []string
+	for _, d := range ds {
+		out = append(out, d.Severity.String()+": "+d.Error())
+	}
+	return out
+}
+
+func TestParseDiagnostics(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		opts ParseOptions
+		want []string
+	}{
+		{
+			"stops at the first error",
+			decodeYAML,
+			ParseOptions{Filename: "x.yaml"},
+			[]string{`error: x.yaml:7:10: presets[1].groups[0]: type is not int (!!str): "oops"`},
+		},
+		{
+			"keep going",
+			decodeYAML,
+			ParseOptions{Filename: "x.yaml", KeepGoing: true},
+			[]string{
+				`error: x.yaml:7:10: presets[1].groups[0]: type is not int (!!str): "oops"`,
+				`error: x.yaml:8:3: presets[2]: not a list (!!int)`,
+				`error: x.yaml:9:16: flagOrder[1]: type is not int (!!str): "two"`,
+			},
+		},
+		{
+			"wrong type in a tab",
+			"tabSetup:\n- - 0\n  - - - name\n      - 5\n",
+			ParseOptions{},
+			[]string{`error: 4:9: tabSetup[0].name: type is not str (!!int): "5"`},
+		},
+		{
+			"not a mapping",
+			"- a\n",
+			ParseOptions{},
+			[]string{`error: 1:1: overview is not a mapping (list)`},
+		},
+		{
+			"syntax error",
+			"presets: [\n",
+			ParseOptions{Filename: "x.yaml"},
+			[]string{`error: x.yaml:1: did not find expected node content`},
+		},
+		{
+			"unknown names are warnings",
+			"presets:\n- - pvp\n  - - - groups\n      - [25]\n    - - mystery\n      - 1\nnewSection: 3\n",
+			ParseOptions{},
+			[]string{
+				`warning: 6:9: presets[0].mystery: unrecognised preset "pvp" attribute "mystery", passing through unchanged`,
+				`warning: 7:13: newSection: unrecognised top-level section "newSection", passing through unchanged`,
+			},
+		},
+		{
+			"unknown names are errors in strict mode",
+			"presets:\n- - pvp\n  - - - groups\n      - [25]\n    - - mystery\n      - 1\nnewSection: 3\n",
+			ParseOptions{Strict: true},
+			[]string{`error: 6:9: presets[0].mystery: unknown Preset attribute "mystery"`},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, ds := ParseWithOptions([]byte(test.in), test.opts)
+			if got := diagStrings(ds); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("diagnostics:\n got: %q\nwant: %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestParseKeepGoingSkipsBrokenEntries(t *testing.T) {
+	o, ds := ParseWithOptions([]byte(decodeYAML), ParseOptions{KeepGoing: true})
+	if !ds.HasErrors() {
+		t.Fatalf("no errors reported")
+	}
+	// Only the broken group is skipped from "bad", and the entry that isn't
+	// a preset at all is dropped.
+	got := map[string][]InvGroupId{}
+	for _, p := range o.Presets {
+		got[p.Name] = p.Groups.Groups
+	}
+	if want := map[string][]InvGroupId{"pvp": {25}, "bad": {}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("preset groups = %v, want %v", got, want)
+	}
+	if want := []StateType{1}; !reflect.DeepEqual(o.FlagOrder, want) {
+		t.Errorf("flagOrder = %v, want %v", o.FlagOrder, want)
+	}
+}
+
+func TestParseMatchesParseWithOptions(t *testing.T) {
+	_, err := Parse([]byte(decodeYAML))
+	_, ds := ParseWithOptions([]byte(decodeYAML), ParseOptions{})
+	if err == nil || len(ds) != 1 || err.Error() != ds[0].Error() {
+		t.Errorf("Parse error %v doesn't match ParseWithOptions %q", err, diagStrings(ds))
+	}
+}
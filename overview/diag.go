@@ -0,0 +1,124 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overview
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+type Severity int
+
+const (
+	SevError Severity = iota
+	SevWarning
+)
+
+func (s Severity) String() string {
+	switch s {
+	case SevError:
+		return "error"
+	case SevWarning:
+		return "warning"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseSeverity parses "error" or "warning".
+func ParseSeverity(s string) (Severity, error) {
+	switch strings.ToLower(s) {
+	case "error":
+		return SevError, nil
+	case "warning":
+		return SevWarning, nil
+	}
+	return SevError, fmt.Errorf("unknown severity %+q", s)
+}
+
+// Position is a location in an overview file. Line and Column start at 1, and
+// are 0 if unknown.
+type Position struct {
+	File   string
+	Line   int
+	Column int
+}
+
+func (p Position) String() string {
+	s := p.File
+	if p.Line > 0 {
+		if s != "" {
+			s += ":"
+		}
+		s += fmt.Sprintf("%d", p.Line)
+		if p.Column > 0 {
+			s += fmt.Sprintf(":%d", p.Column)
+		}
+	}
+	return s
+}
+
+// Diagnostic is a problem found in an overview file. Path identifies the
+// element the problem is with, e.g. "presets[4].groups[12]".
+type Diagnostic struct {
+	Severity Severity
+	Pos      Position
+	Path     string
+	Message  string
+	// Rule is the ID of the lint rule that found the problem, if any.
+	Rule string
+}
+
+func (d *Diagnostic) Error() string {
+	s := d.Message
+	if d.Path != "" {
+		s = fmt.Sprintf("%s: %s", d.Path, s)
+	}
+	if pos := d.Pos.String(); pos != "" {
+		s = fmt.Sprintf("%s: %s", pos, s)
+	}
+	if d.Rule != "" {
+		s = fmt.Sprintf("%s [%s]", s, d.Rule)
+	}
+	return s
+}
+
+type Diagnostics []*Diagnostic
+
+// HasErrors returns true if any of the diagnostics are errors.
+func (ds Diagnostics) HasErrors() bool {
+	return ds.Err() != nil
+}
+
+// Err returns the first error diagnostic, or nil if there are none.
+func (ds Diagnostics) Err() error {
+	for _, d := range ds {
+		if d.Severity == SevError {
+			return d
+		}
+	}
+	return nil
+}
+
+// sort orders the diagnostics by their position in the file.
+func (ds Diagnostics) sort() {
+	sort.SliceStable(ds, func(i, j int) bool {
+		if ds[i].Pos.Line != ds[j].Pos.Line {
+			return ds[i].Pos.Line < ds[j].Pos.Line
+		}
+		return ds[i].Pos.Column < ds[j].Pos.Column
+	})
+}
@@ -0,0 +1,515 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overview
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Status describes how an entry differs between two overviews.
+type Status string
+
+const (
+	Added   Status = "added"
+	Removed Status = "removed"
+	Changed Status = "changed"
+)
+
+// Delta is the semantic difference between two overviews. Sections that
+// haven't changed are left empty.
+type Delta struct {
+	Presets          []*PresetDelta `json:"presets,omitempty"`
+	TabSetup         []*EntryDelta  `json:"tabSetup,omitempty"`
+	ShipLabels       []*EntryDelta  `json:"shipLabels,omitempty"`
+	ShipLabelOrder   *ListDelta     `json:"shipLabelOrder,omitempty"`
+	StateColors      []*EntryDelta  `json:"stateColorsNameList,omitempty"`
+	StateBlinks      []*EntryDelta  `json:"stateBlinks,omitempty"`
+	UserSettings     []*EntryDelta  `json:"userSettings,omitempty"`
+	ColumnOrder      *ListDelta     `json:"columnOrder,omitempty"`
+	OverviewColumns  *ListDelta     `json:"overviewColumns,omitempty"`
+	BackgroundOrder  *ListDelta     `json:"backgroundOrder,omitempty"`
+	BackgroundStates *ListDelta     `json:"backgroundStates,omitempty"`
+	FlagOrder        *ListDelta     `json:"flagOrder,omitempty"`
+	FlagStates       *ListDelta     `json:"flagStates,omitempty"`
+	// Extra holds the changes to top-level sections this package doesn't
+	// know about.
+	Extra []AttrChange `json:"extra,omitempty"`
+}
+
+// Empty returns true if the two overviews are the same.
+func (d *Delta) Empty() bool {
+	return reflect.DeepEqual(d, &Delta{})
+}
+
+// PresetDelta is the difference between two versions of a preset. Groups
+// and states are compared as sets, as their order doesn't matter.
+type PresetDelta struct {
+	Name              string       `json:"name"`
+	Status            Status       `json:"status"`
+	Groups            *ListDelta   `json:"groups,omitempty"`
+	AlwaysShownStates *ListDelta   `json:"alwaysShownStates,omitempty"`
+	FilteredStates    *ListDelta   `json:"filteredStates,omitempty"`
+	Extra             []AttrChange `json:"extra,omitempty"`
+}
+
+// ListDelta is the difference between two versions of a list.
+type ListDelta struct {
+	Added   []Item `json:"added,omitempty"`
+	Removed []Item `json:"removed,omitempty"`
+	// Reordered is set if the entries common to both versions of an ordered
+	// list are in a different order, in which case Old and New hold the full
+	// lists.
+	Reordered bool   `json:"reordered,omitempty"`
+	Old       []Item `json:"old,omitempty"`
+	New       []Item `json:"new,omitempty"`
+}
+
+// Item is an entry in a list, along with its description.
+type Item struct {
+	// ID is an int for groups and states, and a string for columns and ship
+	// labels.
+	ID   interface{} `json:"id"`
+	Name string      `json:"name,omitempty"`
+}
+
+func (it Item) key() string {
+	return fmt.Sprint(it.ID)
+}
+
+// EntryDelta is the difference between two versions of a keyed entry, e.g.
+// a tab or a ship label. Added and removed entries list all their attributes.
+type EntryDelta struct {
+	Key string `json:"key"`
+	// Desc describes the entry, e.g. the name of a tab.
+	Desc   string       `json:"desc,omitempty"`
+	Status Status       `json:"status"`
+	Attrs  []AttrChange `json:"attrs,omitempty"`
+}
+
+// AttrChange is a changed attribute. Old or New are nil if the attribute was
+// added or removed.
+type AttrChange struct {
+	Name string      `json:"name"`
+	Old  interface{} `json:"old"`
+	New  interface{} `json:"new"`
+}
+
+// Diff returns the semantic difference between overviews a and b, with IDs
+// described using c.
+func Diff(a, b *Overview, c *Catalog) *Delta {
+	d := &Delta{}
+	d.Presets = diffPresets(a.Presets, b.Presets, c)
+	d.TabSetup = diffEntries(tabEntries(a.TabSetup), tabEntries(b.TabSetup))
+	d.ShipLabels = diffEntries(labelEntries(a.ShipLabels), labelEntries(b.ShipLabels))
+	d.ShipLabelOrder = diffOrder(labelItems(a.ShipLabelOrder), labelItems(b.ShipLabelOrder))
+	d.StateColors = diffEntries(stateColorEntries(a.StateColorsNameList, c),
+		stateColorEntries(b.StateColorsNameList, c))
+	d.StateBlinks = diffEntries(stateBlinkEntries(a.StateBlinks, c), stateBlinkEntries(b.StateBlinks, c))
+	d.UserSettings = diffEntries(settingEntries(a.UserSettings), settingEntries(b.UserSettings))
+	d.ColumnOrder = diffOrder(columnItems(a.ColumnOrder, c), columnItems(b.ColumnOrder, c))
+	d.OverviewColumns = diffSet(columnItems(a.OverviewColumns, c), columnItems(b.OverviewColumns, c))
+	d.BackgroundOrder = diffOrder(stateItems(a.BackgroundOrder, c), stateItems(b.BackgroundOrder, c))
+	d.BackgroundStates = diffSet(stateItems(a.BackgroundStates, c), stateItems(b.BackgroundStates, c))
+	d.FlagOrder = diffOrder(stateItems(a.FlagOrder, c), stateItems(b.FlagOrder, c))
+	d.FlagStates = diffSet(stateItems(a.FlagStates, c), stateItems(b.FlagStates, c))
+	d.Extra = diffAttrs(rawAttrs(a.Extra), rawAttrs(b.Extra))
+	return d
+}
+
+func diffPresets(a, b []*Preset, c *Catalog) []*PresetDelta {
+	keys := func(ps []*Preset) ([]string, map[string]*Preset) {
+		names := make([]string, len(ps))
+		for i, p := range ps {
+			names[i] = p.Name
+		}
+		names = uniqueKeys(names)
+		m := make(map[string]*Preset, len(ps))
+		for i, p := range ps {
+			m[names[i]] = p
+		}
+		return names, m
+	}
+	aNames, aByName := keys(a)
+	bNames, bByName := keys(b)
+	var out []*PresetDelta
+	add := func(name string, st Status, pa, pb *Preset) {
+		pd := &PresetDelta{
+			Name:              name,
+			Status:            st,
+			Groups:            diffSet(groupItems(pa.groups(), c), groupItems(pb.groups(), c)),
+			AlwaysShownStates: diffSet(stateItems(pa.alwaysShown(), c), stateItems(pb.alwaysShown(), c)),
+			FilteredStates:    diffSet(stateItems(pa.filtered(), c), stateItems(pb.filtered(), c)),
+			Extra:             diffAttrs(rawAttrs(pa.extra()), rawAttrs(pb.extra())),
+		}
+		if st != Changed || pd.Groups != nil || pd.AlwaysShownStates != nil ||
+			pd.FilteredStates != nil || pd.Extra != nil {
+			out = append(out, pd)
+		}
+	}
+	for _, name := range aNames {
+		if pb, ok := bByName[name]; ok {
+			add(name, Changed, aByName[name], pb)
+		} else {
+			add(name, Removed, aByName[name], nil)
+		}
+	}
+	for _, name := range bNames {
+		if _, ok := aByName[name]; !ok {
+			add(name, Added, nil, bByName[name])
+		}
+	}
+	return out
+}
+
+func (p *Preset) groups() []InvGroupId {
+	if p == nil || p.Groups == nil {
+		return nil
+	}
+	return p.Groups.Groups
+}
+
+func (p *Preset) extra() []RawAttr {
+	if p == nil {
+		return nil
+	}
+	return p.Extra
+}
+
+func (p *Preset) alwaysShown() []StateType {
+	if p == nil || p.AlwaysShownStates == nil {
+		return nil
+	}
+	return p.AlwaysShownStates.States
+}
+
+func (p *Preset) filtered() []StateType {
+	if p == nil || p.FilteredStates == nil {
+		return nil
+	}
+	return p.FilteredStates.States
+}
+
+// diffSet compares two lists as sets. It returns nil if they hold the same
+// entries.
+func diffSet(a, b []Item) *ListDelta {
+	inA, inB := itemKeys(a), itemKeys(b)
+	ld := &ListDelta{}
+	for _, it := range b {
+		if !inA[it.key()] {
+			ld.Added = append(ld.Added, it)
+			inA[it.key()] = true
+		}
+	}
+	for _, it := range a {
+		if !inB[it.key()] {
+			ld.Removed = append(ld.Removed, it)
+			inB[it.key()] = true
+		}
+	}
+	if ld.Added == nil && ld.Removed == nil {
+		return nil
+	}
+	return ld
+}
+
+// diffOrder compares two ordered lists. It returns nil if they are the same.
+func diffOrder(a, b []Item) *ListDelta {
+	ld := diffSet(a, b)
+	inA, inB := itemKeys(a), itemKeys(b)
+	var commonA, commonB []string
+	for _, it := range a {
+		if inB[it.key()] {
+			commonA = append(commonA, it.key())
+		}
+	}
+	for _, it := range b {
+		if inA[it.key()] {
+			commonB = append(commonB, it.key())
+		}
+	}
+	if reflect.DeepEqual(commonA, commonB) {
+		return ld
+	}
+	if ld == nil {
+		ld = &ListDelta{}
+	}
+	ld.Reordered, ld.Old, ld.New = true, a, b
+	return ld
+}
+
+func itemKeys(its []Item) map[string]bool {
+	m := make(map[string]bool, len(its))
+	for _, it := range its {
+		m[it.key()] = true
+	}
+	return m
+}
+
+func groupItems(igs []InvGroupId, c *Catalog) []Item {
+	out := make([]Item, len(igs))
+	for i, ig := range igs {
+		out[i] = Item{ID: int(ig), Name: c.GroupName(ig)}
+	}
+	return out
+}
+
+func stateItems(sts []StateType, c *Catalog) []Item {
+	out := make([]Item, len(sts))
+	for i, st := range sts {
+		out[i] = Item{ID: int(st), Name: c.StateName(st)}
+	}
+	return out
+}
+
+func columnItems(cols []Column, c *Catalog) []Item {
+	out := make([]Item, len(cols))
+	for i, col := range cols {
+		out[i] = Item{ID: string(col), Name: c.ColumnName(col)}
+	}
+	return out
+}
+
+func labelItems(nss []NullableString) []Item {
+	out := make([]Item, len(nss))
+	for i, ns := range nss {
+		out[i] = Item{ID: ns.key()}
+	}
+	return out
+}
+
+// key returns the string, or "null" if it is empty.
+func (ns NullableString) key() string {
+	if ns == "" {
+		return "null"
+	}
+	return string(ns)
+}
+
+// value returns the string, or nil if it is empty.
+func (ns NullableString) value() interface{} {
+	if ns == "" {
+		return nil
+	}
+	return string(ns)
+}
+
+// entry is a keyed entry with named attributes, in a form that can be
+// compared generically.
+type entry struct {
+	key   string
+	desc  string
+	attrs []AttrChange
+}
+
+// attr returns an attribute for an entry, with the value in New.
+func attr(name string, v interface{}) AttrChange {
+	return AttrChange{Name: name, New: v}
+}
+
+func boolValue(b *bool) interface{} {
+	if b == nil {
+		return nil
+	}
+	return *b
+}
+
+func rawValue(n *yaml.Node) interface{} {
+	if n == nil {
+		return nil
+	}
+	b, err := yaml.Marshal(n)
+	if err != nil {
+		return n.Value
+	}
+	return strings.TrimSpace(string(b))
+}
+
+func rawAttrs(ras []RawAttr) []AttrChange {
+	var out []AttrChange
+	for _, ra := range ras {
+		out = append(out, attr(ra.Name, rawValue(ra.Value)))
+	}
+	return out
+}
+
+func tabEntries(tss []*TabSetup) []entry {
+	out := make([]entry, len(tss))
+	for i, ts := range tss {
+		out[i] = entry{
+			key:  strconv.Itoa(ts.Id),
+			desc: ts.Name,
+			attrs: append([]AttrChange{
+				attr("bracket", ts.Bracket.value()),
+				attr("name", ts.Name),
+				attr("overview", ts.Overview),
+				attr("showAll", boolValue(ts.ShowAll)),
+				attr("showNone", boolValue(ts.ShowNone)),
+				attr("showSpecials", boolValue(ts.ShowSpecials)),
+			}, rawAttrs(ts.Extra)...),
+		}
+	}
+	return out
+}
+
+func labelEntries(sls []*ShipLabel) []entry {
+	out := make([]entry, len(sls))
+	for i, sl := range sls {
+		out[i] = entry{
+			key: sl.Name.key(),
+			attrs: []AttrChange{
+				attr("post", sl.Post),
+				attr("pre", sl.Pre),
+				attr("state", sl.State.String()),
+				attr("type", sl.Type.value()),
+			},
+		}
+	}
+	return out
+}
+
+// stateDesc describes the state referred to by a per-state setting name, e.g.
+// "flag_11".
+func stateDesc(name string, c *Catalog) string {
+	m := stateNameRx.FindStringSubmatch(name)
+	if m == nil {
+		return ""
+	}
+	id, _ := strconv.Atoi(m[2])
+	return c.StateName(StateType(id))
+}
+
+func stateColorEntries(scs []*StateColorName, c *Catalog) []entry {
+	out := make([]entry, len(scs))
+	for i, sc := range scs {
+		out[i] = entry{key: sc.Name, desc: stateDesc(sc.Name, c),
+			attrs: []AttrChange{attr("color", sc.Val)}}
+	}
+	return out
+}
+
+func stateBlinkEntries(sbs []*StateBlink, c *Catalog) []entry {
+	out := make([]entry, len(sbs))
+	for i, sb := range sbs {
+		out[i] = entry{key: sb.Name, desc: stateDesc(sb.Name, c),
+			attrs: []AttrChange{attr("blink", sb.Val)}}
+	}
+	return out
+}
+
+func settingEntries(uss []*UserSetting) []entry {
+	out := make([]entry, len(uss))
+	for i, us := range uss {
+		out[i] = entry{key: us.Name, attrs: []AttrChange{attr("value", us.Val)}}
+	}
+	return out
+}
+
+// diffEntries compares two lists of entries by key. Entries that were added
+// are listed after the ones from a.
+func diffEntries(a, b []entry) []*EntryDelta {
+	a, b = uniqueEntries(a), uniqueEntries(b)
+	inA := make(map[string]bool, len(a))
+	bByKey := make(map[string]entry, len(b))
+	for _, e := range a {
+		inA[e.key] = true
+	}
+	for _, e := range b {
+		bByKey[e.key] = e
+	}
+	var out []*EntryDelta
+	for _, ea := range a {
+		eb, ok := bByKey[ea.key]
+		if !ok {
+			out = append(out, &EntryDelta{Key: ea.key, Desc: ea.desc, Status: Removed,
+				Attrs: diffAttrs(ea.attrs, nil)})
+			continue
+		}
+		if changes := diffAttrs(ea.attrs, eb.attrs); changes != nil {
+			desc := eb.desc
+			if desc == "" {
+				desc = ea.desc
+			}
+			out = append(out, &EntryDelta{Key: ea.key, Desc: desc, Status: Changed, Attrs: changes})
+		}
+	}
+	for _, eb := range b {
+		if !inA[eb.key] {
+			out = append(out, &EntryDelta{Key: eb.key, Desc: eb.desc, Status: Added,
+				Attrs: diffAttrs(nil, eb.attrs)})
+		}
+	}
+	return out
+}
+
+func uniqueEntries(es []entry) []entry {
+	keys := make([]string, len(es))
+	for i, e := range es {
+		keys[i] = e.key
+	}
+	keys = uniqueKeys(keys)
+	out := make([]entry, len(es))
+	for i, e := range es {
+		e.key = keys[i]
+		out[i] = e
+	}
+	return out
+}
+
+// uniqueKeys makes duplicate keys distinct, by adding "~n" to the second and
+// later occurrences.
+func uniqueKeys(keys []string) []string {
+	seen := make(map[string]int)
+	out := make([]string, len(keys))
+	for i, key := range keys {
+		seen[key]++
+		if seen[key] > 1 {
+			key = fmt.Sprintf("%s~%d", key, seen[key])
+		}
+		out[i] = key
+	}
+	return out
+}
+
+// diffAttrs compares two lists of attributes by name, using the values in
+// New. Attributes that are unset (nil) in both are skipped.
+func diffAttrs(a, b []AttrChange) []AttrChange {
+	var names []string
+	aVals := make(map[string]interface{})
+	bVals := make(map[string]interface{})
+	for _, ac := range a {
+		if !isKnown(ac.Name, names) {
+			names = append(names, ac.Name)
+		}
+		aVals[ac.Name] = ac.New
+	}
+	for _, ac := range b {
+		if !isKnown(ac.Name, names) {
+			names = append(names, ac.Name)
+		}
+		bVals[ac.Name] = ac.New
+	}
+	var out []AttrChange
+	for _, name := range names {
+		if !reflect.DeepEqual(aVals[name], bVals[name]) {
+			out = append(out, AttrChange{Name: name, Old: aVals[name], New: bVals[name]})
+		}
+	}
+	return out
+}
@@ -0,0 +1,86 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overview
+
+// GroupDrift is a category that a preset includes almost all of, apart from
+// groups that have been added to the game since its group lists were made,
+// e.g. a new class of ship in a preset that shows every ship.
+type GroupDrift struct {
+	Preset   string            `json:"preset"`
+	Category *CategoryCoverage `json:"category"`
+	// New are the excluded groups that aren't in the group lists.
+	New []Item `json:"new"`
+}
+
+// Drift returns the categories that each preset includes at least threshold
+// (e.g. 0.8) of, but not groups that are missing from known, the group lists
+// from when the presets were made (see LoadAllGroups). Coverage is against
+// known plus the groups published in c, so groups that are new in the
+// inventory groups data count.
+func Drift(o *Overview, c *Catalog, known AllGroups, threshold float64) []*GroupDrift {
+	current := known.union(PublishedGroups(c))
+	out := []*GroupDrift{}
+	for _, p := range o.Presets {
+		covs, _ := current.Coverage(p.groups(), c)
+		for _, cc := range covs {
+			if cc.Full() || float64(len(cc.Included)) < threshold*float64(cc.Total) {
+				continue
+			}
+			gd := &GroupDrift{Preset: p.Name, Category: cc}
+			for _, it := range cc.Excluded {
+				if !known.Has(InvGroupId(it.ID.(int)), c) {
+					gd.New = append(gd.New, it)
+				}
+			}
+			if len(gd.New) > 0 {
+				out = append(out, gd)
+			}
+		}
+	}
+	return out
+}
+
+// AddNewGroups adds the new groups of each drift to the end of its preset's
+// groups.
+func (o *Overview) AddNewGroups(gds []*GroupDrift) {
+	for _, gd := range gds {
+		p := o.Preset(gd.Preset)
+		if p == nil {
+			continue
+		}
+		if p.Groups == nil {
+			p.Groups = &PresetGroups{}
+		}
+		for _, it := range gd.New {
+			if ig := InvGroupId(it.ID.(int)); !p.hasGroup(ig) {
+				p.Groups.Groups = append(p.Groups.Groups, ig)
+			}
+		}
+	}
+}
+
+// union returns the groups in either ag or other.
+func (ag AllGroups) union(other AllGroups) AllGroups {
+	out := make(AllGroups)
+	for _, src := range []AllGroups{ag, other} {
+		for cat, igs := range src {
+			out[cat] = append(out[cat], igs...)
+		}
+	}
+	for cat, igs := range out {
+		out[cat] = canonicalGroups(igs)
+	}
+	return out
+}
@@ -0,0 +1,215 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overview
+
+import (
+	"bytes"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Marshal returns the yaml representation of o, with every ID annotated with
+// its description from c. Comments from the parsed file are kept, apart from
+// previously generated annotations, which are replaced.
+func Marshal(o *Overview, c *Catalog) ([]byte, error) {
+	return MarshalWithOptions(o, c, DefaultOutputOptions())
+}
+
+// MarshalWithOptions is like Marshal, but with control over the formatting of
+// the output and the annotations.
+func MarshalWithOptions(o *Overview, c *Catalog, opts OutputOptions) ([]byte, error) {
+	e, err := newEncoder(c, opts, o.comments)
+	if err != nil {
+		return nil, err
+	}
+	root, err := o.marshal(e)
+	if err != nil {
+		return nil, err
+	}
+	doc := &yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{root}}
+	o.comments.apply(doc, e)
+	doc.HeadComment = joinComments(opts.templateComment(), doc.HeadComment)
+	if e.err != nil {
+		return nil, e.err
+	}
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(opts.indent())
+	if err := enc.Encode(doc); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return opts.finish(buf.Bytes()), nil
+}
+
+// encoder builds the yaml node tree for the model.
+type encoder struct {
+	*annotator
+	// alts are the annotators for other templates whose annotations are
+	// also recognised as generated: the defaults, and the ones recorded in
+	// the file being written (see templateDirectives).
+	alts []*annotator
+	// annotations records how the annotation of each annotated node was
+	// generated, so it can be told apart from comments added by hand.
+	annotations map[*yaml.Node]annotation
+}
+
+// annotation generates the annotation of a node.
+type annotation func(a *annotator) string
+
+// newEncoder returns an encoder for writing a file with the given comments.
+func newEncoder(c *Catalog, opts OutputOptions, cs comments) (*encoder, error) {
+	a, err := newAnnotator(c, opts)
+	if err != nil {
+		return nil, err
+	}
+	e := &encoder{annotator: a, annotations: make(map[*yaml.Node]annotation)}
+	for _, alt := range []OutputOptions{DefaultOutputOptions(), cs.templates()} {
+		// A broken template recorded in the file is ignored, as it
+		// couldn't have generated anything.
+		if a, err := newAnnotator(c, alt); err == nil {
+			e.alts = append(e.alts, a)
+		}
+	}
+	return e, nil
+}
+
+// annotate sets the line comment of n to the annotation generated by f.
+func (e *encoder) annotate(n *yaml.Node, f annotation) *yaml.Node {
+	n.LineComment = "# " + f(e.annotator)
+	e.annotations[n] = f
+	return n
+}
+
+// generated returns the annotations that could have been generated for n,
+// which has already been annotated, with the current, default or previously
+// used templates, and so aren't comments added by hand.
+func (e *encoder) generated(n *yaml.Node) []string {
+	if n.LineComment == "" {
+		return nil
+	}
+	out := []string{strings.TrimPrefix(n.LineComment, "# ")}
+	if f, ok := e.annotations[n]; ok {
+		for _, a := range e.alts {
+			out = append(out, f(a))
+		}
+	}
+	return out
+}
+
+// toNode converts v into a yaml node tree. This is done by hand rather than
+// via yaml.Node.Encode, as that drops the comments of any nodes inside v.
+func toNode(v interface{}) (*yaml.Node, error) {
+	switch v := v.(type) {
+	case *yaml.Node:
+		return v, nil
+	case yaml.Marshaler:
+		mv, err := v.MarshalYAML()
+		if err != nil {
+			return nil, err
+		}
+		return toNode(mv)
+	}
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Slice {
+		n := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+		for i := 0; i < rv.Len(); i++ {
+			c, err := toNode(rv.Index(i).Interface())
+			if err != nil {
+				return nil, err
+			}
+			n.Content = append(n.Content, c)
+		}
+		return n, nil
+	}
+	n := &yaml.Node{}
+	if err := n.Encode(v); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+// annotated returns a scalar node for n, with desc as a line comment.
+func annotated(n int, desc string) *yaml.Node {
+	node := intNode(n)
+	node.LineComment = "# " + desc
+	return node
+}
+
+func intNode(n int) *yaml.Node {
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!int", Value: strconv.Itoa(n)}
+}
+
+func (e *encoder) states(sts []StateType) []*yaml.Node {
+	out := make([]*yaml.Node, len(sts))
+	for i, st := range sts {
+		st := st
+		out[i] = e.annotate(intNode(int(st)), func(a *annotator) string { return a.stateComment(st) })
+	}
+	return out
+}
+
+func (e *encoder) groups(igs []InvGroupId) []*yaml.Node {
+	out := make([]*yaml.Node, len(igs))
+	for i, ig := range igs {
+		ig := ig
+		out[i] = e.annotate(intNode(int(ig)), func(a *annotator) string { return a.groupComment(ig) })
+	}
+	return out
+}
+
+// stateNameRx matches the names used for the per-state colour and blink
+// settings, e.g. "flag_11" or "background_13".
+var stateNameRx = regexp.MustCompile(`^(flag|background)_(\d+)$`)
+
+// stateName returns a node for a per-state setting name, annotated with the
+// description of the state it refers to.
+func (e *encoder) stateName(name string) *yaml.Node {
+	n := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: name}
+	if m := stateNameRx.FindStringSubmatch(name); m != nil {
+		id, _ := strconv.Atoi(m[2])
+		e.annotate(n, func(a *annotator) string { return a.stateComment(StateType(id)) })
+	}
+	return n
+}
+
+func (e *encoder) presets(ps []*Preset) []interface{} {
+	out := make([]interface{}, len(ps))
+	for i, p := range ps {
+		out[i] = p.marshal(e)
+	}
+	return out
+}
+
+func (e *encoder) stateBlinks(sbs []*StateBlink) []interface{} {
+	out := make([]interface{}, len(sbs))
+	for i, sb := range sbs {
+		out[i] = []interface{}{e.stateName(sb.Name), sb.Val}
+	}
+	return out
+}
+
+func (e *encoder) stateColors(scs []*StateColorName) []interface{} {
+	out := make([]interface{}, len(scs))
+	for i, sc := range scs {
+		out[i] = []interface{}{e.stateName(sc.Name), sc.Val}
+	}
+	return out
+}
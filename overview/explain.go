@@ -0,0 +1,66 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overview
+
+import (
+	"fmt"
+)
+
+// PresetExplanation describes what a preset shows, in terms of categories
+// rather than group IDs.
+type PresetExplanation struct {
+	Name   string `json:"name"`
+	Groups int    `json:"groups"`
+	// Categories are the categories the preset shows groups from.
+	Categories []*CategoryCoverage `json:"categories"`
+	// Unlisted are the preset's groups that aren't in the list of groups
+	// that can be shown on the overview.
+	Unlisted    []Item   `json:"unlisted,omitempty"`
+	AlwaysShown []Item   `json:"alwaysShown,omitempty"`
+	Filtered    []Item   `json:"filtered,omitempty"`
+	OverviewFor []TabRef `json:"overviewFor,omitempty"`
+	BracketFor  []TabRef `json:"bracketFor,omitempty"`
+}
+
+// TabRef identifies a tab.
+type TabRef struct {
+	Id   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// ExplainPreset describes the named preset, with the categories it shows
+// groups from compared against ag.
+func ExplainPreset(o *Overview, c *Catalog, ag AllGroups, name string) (*PresetExplanation, error) {
+	p := o.Preset(name)
+	if p == nil {
+		return nil, fmt.Errorf("no preset named %+q", name)
+	}
+	pe := &PresetExplanation{
+		Name:        p.Name,
+		Groups:      len(p.groups()),
+		AlwaysShown: stateItems(p.alwaysShown(), c),
+		Filtered:    stateItems(p.filtered(), c),
+	}
+	pe.Categories, pe.Unlisted = ag.Coverage(p.groups(), c)
+	for _, ts := range o.TabSetup {
+		if ts.Overview == p.Name {
+			pe.OverviewFor = append(pe.OverviewFor, TabRef{Id: ts.Id, Name: ts.Name})
+		}
+		if string(ts.Bracket) == p.Name {
+			pe.BracketFor = append(pe.BracketFor, TabRef{Id: ts.Id, Name: ts.Name})
+		}
+	}
+	return pe, nil
+}
@@ -0,0 +1,79 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overview
+
+import (
+	"fmt"
+)
+
+// Extract returns a standalone overview with just some of the presets and
+// tabs of o. Each name is that of a preset or a tab (or both). Along with the
+// named presets it has the tabs that use them, and along with the named tabs
+// the presets they use, so no tab refers to a missing preset. If appearance
+// is set, the state colours and blinks, flag and background states and ship
+// labels are copied too. Comments, and any sections that aren't recognised,
+// are kept.
+func Extract(o *Overview, names []string, appearance bool) (*Overview, error) {
+	presets := make(map[string]bool)
+	tabs := make(map[*TabSetup]bool)
+	for _, name := range names {
+		found := false
+		if o.Preset(name) != nil {
+			presets[name] = true
+			found = true
+		}
+		for _, ts := range o.TabSetup {
+			if ts.Name == name {
+				tabs[ts] = true
+				found = true
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("no preset or tab named %+q", name)
+		}
+	}
+	out := &Overview{file: o.file, order: o.order, Extra: o.Extra,
+		comments: make(comments, len(o.comments))}
+	for path, nc := range o.comments {
+		out.comments[path] = nc
+	}
+	for _, ts := range o.TabSetup {
+		if tabs[ts] || presets[ts.Overview] || presets[string(ts.Bracket)] {
+			out.TabSetup = append(out.TabSetup, ts)
+		}
+	}
+	for _, ts := range out.TabSetup {
+		presets[ts.Overview] = true
+		if ts.Bracket != "" {
+			presets[string(ts.Bracket)] = true
+		}
+	}
+	for _, p := range o.Presets {
+		if presets[p.Name] {
+			out.Presets = append(out.Presets, p)
+		}
+	}
+	if appearance {
+		out.StateColorsNameList = o.StateColorsNameList
+		out.StateBlinks = o.StateBlinks
+		out.FlagOrder = o.FlagOrder
+		out.FlagStates = o.FlagStates
+		out.BackgroundOrder = o.BackgroundOrder
+		out.BackgroundStates = o.BackgroundStates
+		out.ShipLabels = o.ShipLabels
+		out.ShipLabelOrder = o.ShipLabelOrder
+	}
+	return out, nil
+}
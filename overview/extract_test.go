@@ -0,0 +1,49 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overview
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestExtractKeepsUnknownSections(t *testing.T) {
+	c := testCatalog(t)
+	o, err := Parse([]byte(`newThing:
+- - a
+  - 1
+presets:
+- - pvp
+  - - - groups
+      - - 25
+- - mining
+  - - - groups
+      - - 26
+`))
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	ex, err := Extract(o, []string{"pvp"}, false)
+	if err != nil {
+		t.Fatalf("Extract: %v", err)
+	}
+	out := marshalLF(t, ex, c)
+	if strings.Contains(out, "null") || lineWith(out, "- a") != "- - a" {
+		t.Errorf("unknown section not kept:\n%s", out)
+	}
+	if strings.Contains(out, "mining") {
+		t.Errorf("preset not named was extracted:\n%s", out)
+	}
+}
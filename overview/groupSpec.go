@@ -0,0 +1,356 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overview
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// GroupSpec is a category-relative description of a list of groups, e.g.
+// "all Ship except Capsule, Shuttle". It's written as a list of lines (see
+// Lines), which can be parsed back with ParseGroupSpec.
+type GroupSpec struct {
+	Categories []*CategorySpec
+	// Unknown are the groups that aren't in the inventory groups data.
+	Unknown []InvGroupId
+}
+
+// CategorySpec describes the groups included from one category.
+type CategorySpec struct {
+	Category InvCategoryId
+	// All is set if every group of the category is included, apart from
+	// Except.
+	All    bool
+	Except []InvGroupId
+	// Groups are the groups included other than by All.
+	Groups []InvGroupId
+}
+
+// DescribeGroups returns a category-relative description of igs. Categories
+// that igs has more than half of the groups of, according to ag, are
+// described as all of the category except the groups left out, and the rest
+// as a list of groups.
+func DescribeGroups(igs []InvGroupId, ag AllGroups, c *Catalog) *GroupSpec {
+	gs := &GroupSpec{}
+	byCat := make(map[InvCategoryId][]InvGroupId)
+	var cats []InvCategoryId
+	for _, ig := range canonicalGroups(igs) {
+		g, ok := c.Groups[ig]
+		if !ok {
+			gs.Unknown = append(gs.Unknown, ig)
+			continue
+		}
+		if _, ok := byCat[g.Cat]; !ok {
+			cats = append(cats, g.Cat)
+		}
+		byCat[g.Cat] = append(byCat[g.Cat], ig)
+	}
+	sort.Slice(cats, func(i, j int) bool { return cats[i] < cats[j] })
+	for _, cat := range cats {
+		cs := &CategorySpec{Category: cat}
+		included := make(map[InvGroupId]bool)
+		for _, ig := range byCat[cat] {
+			included[ig] = true
+		}
+		n := 0
+		for _, ig := range ag[cat] {
+			if included[ig] {
+				n++
+			}
+		}
+		if 2*n > len(ag[cat]) {
+			cs.All = true
+			listed := make(map[InvGroupId]bool)
+			for _, ig := range ag[cat] {
+				listed[ig] = true
+				if !included[ig] {
+					cs.Except = append(cs.Except, ig)
+				}
+			}
+			for _, ig := range byCat[cat] {
+				if !listed[ig] {
+					cs.Groups = append(cs.Groups, ig)
+				}
+			}
+		} else {
+			cs.Groups = byCat[cat]
+		}
+		gs.Categories = append(gs.Categories, cs)
+	}
+	return gs
+}
+
+// Lines returns the description as lines of text, e.g.:
+//
+//	all Ship except Capsule, Shuttle
+//	Celestial: Sun, Stargate
+//	123456
+//
+// Names that are ambiguous, or that wouldn't parse, are written as IDs.
+func (gs *GroupSpec) Lines(c *Catalog) []string {
+	var out []string
+	for _, cs := range gs.Categories {
+		cat := c.categoryRef(cs.Category)
+		if cs.All {
+			line := "all " + cat
+			if len(cs.Except) > 0 {
+				line += " except " + c.groupRefs(cs.Category, cs.Except)
+			}
+			out = append(out, line)
+		}
+		if len(cs.Groups) > 0 {
+			out = append(out, cat+": "+c.groupRefs(cs.Category, cs.Groups))
+		}
+	}
+	if len(gs.Unknown) > 0 {
+		var ids []string
+		for _, ig := range gs.Unknown {
+			ids = append(ids, strconv.Itoa(int(ig)))
+		}
+		out = append(out, strings.Join(ids, ", "))
+	}
+	return out
+}
+
+// Compile returns the groups described, using ag for the groups of each
+// category.
+func (gs *GroupSpec) Compile(ag AllGroups) []InvGroupId {
+	var igs []InvGroupId
+	for _, cs := range gs.Categories {
+		if cs.All {
+			except := make(map[InvGroupId]bool)
+			for _, ig := range cs.Except {
+				except[ig] = true
+			}
+			for _, ig := range ag[cs.Category] {
+				if !except[ig] {
+					igs = append(igs, ig)
+				}
+			}
+		}
+		igs = append(igs, cs.Groups...)
+	}
+	igs = append(igs, gs.Unknown...)
+	return canonicalGroups(igs)
+}
+
+// ParseGroupSpec parses the lines of a group description, as written by
+// Lines. Each line is one of:
+//
+//	all CATEGORY
+//	all CATEGORY except GROUP, ...
+//	CATEGORY: GROUP, ...
+//	ID, ...
+//
+// Categories and groups are given by name (ignoring case) or ID.
+func ParseGroupSpec(lines []string, c *Catalog) (*GroupSpec, error) {
+	gs := &GroupSpec{}
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		lower := strings.ToLower(line)
+		var err error
+		switch {
+		case strings.HasPrefix(lower, "all "):
+			cs := &CategorySpec{All: true}
+			rest, except := line[len("all "):], ""
+			if i := strings.Index(strings.ToLower(rest), " except "); i >= 0 {
+				rest, except = rest[:i], rest[i+len(" except "):]
+			}
+			if cs.Category, err = c.findCategory(rest); err != nil {
+				return nil, fmt.Errorf("%+q: %v", line, err)
+			}
+			if except != "" {
+				if cs.Except, err = c.findGroups(cs.Category, except); err != nil {
+					return nil, fmt.Errorf("%+q: %v", line, err)
+				}
+			}
+			gs.Categories = append(gs.Categories, cs)
+		case strings.Contains(line, ":"):
+			i := strings.Index(line, ":")
+			cs := &CategorySpec{}
+			if cs.Category, err = c.findCategory(line[:i]); err != nil {
+				return nil, fmt.Errorf("%+q: %v", line, err)
+			}
+			if cs.Groups, err = c.findGroups(cs.Category, line[i+1:]); err != nil {
+				return nil, fmt.Errorf("%+q: %v", line, err)
+			}
+			gs.Categories = append(gs.Categories, cs)
+		default:
+			for _, f := range strings.Split(line, ",") {
+				n, err := strconv.Atoi(strings.TrimSpace(f))
+				if err != nil {
+					return nil, fmt.Errorf("%+q: expected a group ID, or \"all CATEGORY\" or "+
+						"\"CATEGORY: GROUP, ...\"", line)
+				}
+				gs.Unknown = append(gs.Unknown, InvGroupId(n))
+			}
+		}
+	}
+	return gs, nil
+}
+
+// PresetSpec is the authoring form of a preset's groups.
+type PresetSpec struct {
+	Name   string
+	Groups *GroupSpec
+}
+
+// ParsePresetSpecs parses a yaml mapping of preset names to the lines of
+// their group descriptions (see ParseGroupSpec), e.g.:
+//
+//	pvp:
+//	  - all Ship except Capsule, Shuttle
+//	  - Celestial: Sun, Stargate
+func ParsePresetSpecs(b []byte, c *Catalog) ([]*PresetSpec, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(b, &doc); err != nil {
+		return nil, err
+	}
+	if len(doc.Content) == 0 {
+		return nil, nil
+	}
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("expected a mapping of preset names to group descriptions")
+	}
+	var out []*PresetSpec
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		name := root.Content[i].Value
+		lines, err := specLines(root.Content[i+1])
+		if err != nil {
+			return nil, fmt.Errorf("preset %+q: %v", name, err)
+		}
+		gs, err := ParseGroupSpec(lines, c)
+		if err != nil {
+			return nil, fmt.Errorf("preset %+q: %v", name, err)
+		}
+		out = append(out, &PresetSpec{Name: name, Groups: gs})
+	}
+	return out, nil
+}
+
+// specLines returns the lines of a group description in a spec file. Lines
+// of the form "CATEGORY: GROUP, ..." are mappings in yaml, so those are
+// accepted too, with the groups as a string or a list.
+func specLines(n *yaml.Node) ([]string, error) {
+	if n.Kind != yaml.SequenceNode {
+		return nil, fmt.Errorf("expected a list of group descriptions")
+	}
+	var lines []string
+	for _, item := range n.Content {
+		switch item.Kind {
+		case yaml.ScalarNode:
+			lines = append(lines, item.Value)
+		case yaml.MappingNode:
+			for j := 0; j+1 < len(item.Content); j += 2 {
+				var groups []string
+				val := item.Content[j+1]
+				if val.Kind == yaml.SequenceNode {
+					if err := val.Decode(&groups); err != nil {
+						return nil, err
+					}
+				} else {
+					groups = []string{val.Value}
+				}
+				lines = append(lines, item.Content[j].Value+": "+strings.Join(groups, ", "))
+			}
+		default:
+			return nil, fmt.Errorf("line %d: expected a group description", item.Line)
+		}
+	}
+	return lines, nil
+}
+
+// categoryRef returns the name of the category, or its ID if the name is
+// ambiguous or wouldn't parse.
+func (c *Catalog) categoryRef(ic InvCategoryId) string {
+	name := c.CategoryName(ic)
+	if _, err := c.findCategory(name); err != nil || strings.ContainsAny(name, ":,") ||
+		strings.Contains(strings.ToLower(name), " except ") {
+		return strconv.Itoa(int(ic))
+	}
+	return name
+}
+
+// groupRefs returns a comma-separated list of the names of the groups of a
+// category, using IDs for names that are ambiguous or wouldn't parse.
+func (c *Catalog) groupRefs(ic InvCategoryId, igs []InvGroupId) string {
+	var refs []string
+	for _, ig := range igs {
+		name := c.groupName(ig)
+		if found, err := c.findGroup(ic, name); err != nil || found != ig || strings.Contains(name, ",") {
+			name = strconv.Itoa(int(ig))
+		}
+		refs = append(refs, name)
+	}
+	return strings.Join(refs, ", ")
+}
+
+func (c *Catalog) findCategory(s string) (InvCategoryId, error) {
+	s = strings.TrimSpace(s)
+	if n, err := strconv.Atoi(s); err == nil {
+		return InvCategoryId(n), nil
+	}
+	var found []InvCategoryId
+	for ic, name := range c.Categories {
+		if strings.EqualFold(strings.TrimSpace(name), s) {
+			found = append(found, ic)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return 0, fmt.Errorf("unknown category %+q", s)
+	case 1:
+		return found[0], nil
+	}
+	return 0, fmt.Errorf("ambiguous category %+q, use its ID", s)
+}
+
+func (c *Catalog) findGroups(ic InvCategoryId, s string) ([]InvGroupId, error) {
+	var igs []InvGroupId
+	for _, f := range strings.Split(s, ",") {
+		ig, err := c.findGroup(ic, f)
+		if err != nil {
+			return nil, err
+		}
+		igs = append(igs, ig)
+	}
+	return igs, nil
+}
+
+func (c *Catalog) findGroup(ic InvCategoryId, s string) (InvGroupId, error) {
+	s = strings.TrimSpace(s)
+	if n, err := strconv.Atoi(s); err == nil {
+		return InvGroupId(n), nil
+	}
+	var found []InvGroupId
+	for _, g := range c.Groups {
+		if g.Cat == ic && strings.EqualFold(strings.TrimSpace(g.Name), s) {
+			found = append(found, g.Id)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return 0, fmt.Errorf("unknown %s group %+q", c.CategoryName(ic), s)
+	case 1:
+		return found[0], nil
+	}
+	return 0, fmt.Errorf("ambiguous %s group %+q, use its ID", c.CategoryName(ic), s)
+}
@@ -0,0 +1,68 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overview
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestGroupSpecRoundTrip(t *testing.T) {
+	c := testCatalog(t)
+	ag := PublishedGroups(c)
+	ships := ag[6]
+	tests := []struct {
+		name  string
+		igs   []InvGroupId
+		first string
+	}{
+		{"empty", nil, ""},
+		{"a few groups", []InvGroupId{26, 25}, "Ship: "},
+		{"most of a category", ships[2:], "all Ship except "},
+		{"all of a category", ships, "all Ship"},
+		{"unknown groups", []InvGroupId{999999, 25}, "Ship: "},
+		{"several categories", append([]InvGroupId{10, 15}, ships[1:]...), "Celestial: "},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			lines := DescribeGroups(test.igs, ag, c).Lines(c)
+			if test.first != "" && (len(lines) == 0 || !strings.HasPrefix(lines[0], test.first)) {
+				t.Errorf("lines = %q, want the first to start with %+q", lines, test.first)
+			}
+			gs, err := ParseGroupSpec(lines, c)
+			if err != nil {
+				t.Fatalf("ParseGroupSpec(%q): %v", lines, err)
+			}
+			if got, want := gs.Compile(ag), canonicalGroups(test.igs); !reflect.DeepEqual(got, want) {
+				t.Errorf("round trip of %q:\n got: %v\nwant: %v", lines, got, want)
+			}
+		})
+	}
+}
+
+func TestParseGroupSpecErrors(t *testing.T) {
+	c := testCatalog(t)
+	for _, line := range []string{
+		"all No Such Category",
+		"Ship: No Such Group",
+		"all Ship except No Such Group",
+		"Frigate",
+	} {
+		if _, err := ParseGroupSpec([]string{line}, c); err == nil {
+			t.Errorf("ParseGroupSpec(%+q) didn't fail", line)
+		}
+	}
+}
@@ -0,0 +1,185 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overview
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// AllGroups lists the groups that can be shown on the overview, by category,
+// i.e. the contents of the client's "All" preset.
+type AllGroups map[InvCategoryId][]InvGroupId
+
+// LoadAllGroups loads the per-category group lists written by update-groups
+// from dir (e.g. "groups/"). Groups that aren't in the catalog are skipped.
+func LoadAllGroups(dir string, c *Catalog) (AllGroups, error) {
+	names, err := filepath.Glob(filepath.Join(dir, "*.yaml"))
+	if err != nil {
+		return nil, err
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("no group lists found in %s", dir)
+	}
+	ag := make(AllGroups)
+	for _, name := range names {
+		b, err := ioutil.ReadFile(name)
+		if err != nil {
+			return nil, err
+		}
+		igs, err := ParseGroupSnippet(b)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", name, err)
+		}
+		for _, ig := range igs {
+			if g, ok := c.Groups[ig]; ok {
+				ag[g.Cat] = append(ag[g.Cat], ig)
+			}
+		}
+	}
+	ag.sort()
+	return ag, nil
+}
+
+// overviewCategories are the inventory categories that have groups in the
+// client's "All" preset.
+var overviewCategories = map[InvCategoryId]bool{
+	2:  true, // Celestial
+	3:  true, // Station
+	6:  true, // Ship
+	8:  true, // Charge
+	11: true, // Entity
+	18: true, // Drone
+	22: true, // Deployable
+	23: true, // Starbase
+	25: true, // Asteroid
+	40: true, // Sovereignty Structures
+	46: true, // Orbitals
+	65: true, // Structure
+	87: true, // Fighter
+}
+
+// PublishedGroups returns the groups published in the SDE, by category, from
+// the categories that can be shown on the overview. It's an approximation of
+// the groups that can be shown on the overview, for when the group lists from
+// update-groups aren't available.
+func PublishedGroups(c *Catalog) AllGroups {
+	ag := make(AllGroups)
+	for _, g := range c.Groups {
+		if g.Published && overviewCategories[g.Cat] {
+			ag[g.Cat] = append(ag[g.Cat], g.Id)
+		}
+	}
+	ag.sort()
+	return ag
+}
+
+func (ag AllGroups) sort() {
+	for _, igs := range ag {
+		sort.Slice(igs, func(i, j int) bool { return igs[i] < igs[j] })
+	}
+}
+
+// Has returns true if ig is one of the groups.
+func (ag AllGroups) Has(ig InvGroupId, c *Catalog) bool {
+	g, ok := c.Groups[ig]
+	if !ok {
+		return false
+	}
+	for _, id := range ag[g.Cat] {
+		if id == ig {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseGroupSnippet parses a list of groups, as written by GroupSnippet.
+func ParseGroupSnippet(b []byte) ([]InvGroupId, error) {
+	var ns []int
+	if err := yaml.Unmarshal(b, &ns); err != nil {
+		return nil, err
+	}
+	igs := make([]InvGroupId, len(ns))
+	for i, n := range ns {
+		igs[i] = InvGroupId(n)
+	}
+	return igs, nil
+}
+
+// CategoryCoverage describes which of the groups of a category a list of
+// groups includes.
+type CategoryCoverage struct {
+	ID   InvCategoryId `json:"id"`
+	Name string        `json:"name"`
+	// Total is the number of groups in the category.
+	Total    int    `json:"total"`
+	Included []Item `json:"included"`
+	Excluded []Item `json:"excluded,omitempty"`
+}
+
+// Full returns true if every group of the category is included.
+func (cc *CategoryCoverage) Full() bool {
+	return len(cc.Excluded) == 0
+}
+
+// Coverage returns the coverage of each category that igs has any groups
+// from, ordered by category ID, along with the groups of igs that aren't in
+// ag.
+func (ag AllGroups) Coverage(igs []InvGroupId, c *Catalog) ([]*CategoryCoverage, []Item) {
+	included := make(map[InvGroupId]bool)
+	for _, ig := range igs {
+		included[ig] = true
+	}
+	var covs []*CategoryCoverage
+	seen := make(map[InvGroupId]bool)
+	for cat, all := range ag {
+		cc := &CategoryCoverage{ID: cat, Name: c.CategoryName(cat), Total: len(all)}
+		for _, ig := range all {
+			seen[ig] = true
+			if included[ig] {
+				cc.Included = append(cc.Included, Item{ID: int(ig), Name: c.groupName(ig)})
+			} else {
+				cc.Excluded = append(cc.Excluded, Item{ID: int(ig), Name: c.groupName(ig)})
+			}
+		}
+		if len(cc.Included) > 0 {
+			covs = append(covs, cc)
+		}
+	}
+	sort.Slice(covs, func(i, j int) bool { return covs[i].ID < covs[j].ID })
+	var unlisted []Item
+	for _, ig := range igs {
+		if !seen[ig] {
+			unlisted = append(unlisted, Item{ID: int(ig), Name: c.GroupName(ig)})
+			seen[ig] = true
+		}
+	}
+	return covs, unlisted
+}
+
+// groupName returns just the name of the group, e.g. "Frigate".
+func (c *Catalog) groupName(ig InvGroupId) string {
+	g, ok := c.Groups[ig]
+	if !ok {
+		return "Unknown InvGroup"
+	}
+	return strings.TrimSpace(g.Name)
+}
@@ -0,0 +1,30 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overview
+
+import "testing"
+
+func TestPublishedGroups(t *testing.T) {
+	c := testCatalog(t)
+	ag := PublishedGroups(c)
+	if !ag.Has(25, c) {
+		t.Errorf("Frigate (25) missing from the published groups")
+	}
+	for _, cat := range []InvCategoryId{9, 16} {
+		if igs := ag[cat]; len(igs) > 0 {
+			t.Errorf("category %s can't be shown on the overview, but has groups %v", c.Category(cat), igs)
+		}
+	}
+}
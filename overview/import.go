@@ -0,0 +1,271 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overview
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ImportStrategy is what Import does with a preset that has the same name as
+// one that already exists.
+type ImportStrategy int
+
+const (
+	// ImportSkip keeps the existing preset.
+	ImportSkip ImportStrategy = iota
+	// ImportOverwrite replaces the existing preset.
+	ImportOverwrite
+	// ImportSuffix adds the preset under a new name, e.g. "pvp (2)".
+	ImportSuffix
+	// ImportMergeGroups adds the groups of the imported preset to the
+	// existing one.
+	ImportMergeGroups
+)
+
+var importStrategyNames = []string{"skip", "overwrite", "suffix", "merge-groups"}
+
+func (is ImportStrategy) String() string {
+	if int(is) < len(importStrategyNames) {
+		return importStrategyNames[is]
+	}
+	return fmt.Sprintf("ImportStrategy(%d)", int(is))
+}
+
+// ParseImportStrategy parses "skip", "overwrite", "suffix" or "merge-groups".
+func ParseImportStrategy(s string) (ImportStrategy, error) {
+	for i, name := range importStrategyNames {
+		if strings.ToLower(s) == name {
+			return ImportStrategy(i), nil
+		}
+	}
+	return ImportSkip, fmt.Errorf("unknown import strategy %+q", s)
+}
+
+// ImportChange describes what Import did with a preset or tab.
+type ImportChange struct {
+	// What is "preset" or "tab".
+	What string
+	Name string
+	// Action is what was done, e.g. "added" or "skipped, already exists".
+	Action string
+}
+
+func (ic *ImportChange) String() string {
+	return fmt.Sprintf("%s %+q: %s", ic.What, ic.Name, ic.Action)
+}
+
+// Import copies presets from src into o. Each name is that of a preset in
+// src, or of a tab, which names the presets it uses, and an empty list imports
+// every preset. Presets with the same name as an existing one are handled
+// according to strategy. If tabs is set, the tabs that use the named presets
+// are added too, with new IDs and updated to use the names the presets were
+// imported as. Any other presets those tabs use are imported as well, but are
+// never merged with or overwrite an existing preset: they are skipped if one
+// with the same name exists, unless strategy is ImportSuffix. Comments from
+// src are kept.
+func (o *Overview) Import(src *Overview, names []string, tabs bool,
+	strategy ImportStrategy) ([]*ImportChange, error) {
+	if len(names) == 0 {
+		for _, p := range src.Presets {
+			names = append(names, p.Name)
+		}
+	}
+	named, err := src.namedPresets(names)
+	if err != nil {
+		return nil, err
+	}
+	ex := &Overview{}
+	if tabs {
+		if ex, err = Extract(src, names, false); err != nil {
+			return nil, err
+		}
+	}
+	// used are the presets that are only imported because an imported tab
+	// uses them.
+	used := make(map[string]bool)
+	for _, p := range ex.Presets {
+		if !named[p.Name] {
+			used[p.Name] = true
+		}
+	}
+	if o.comments == nil {
+		o.comments = make(comments)
+	}
+	var changes []*ImportChange
+	// renamed maps the names of the imported presets to their new ones.
+	renamed := make(map[string]string)
+	for _, p := range src.Presets {
+		if !named[p.Name] && !used[p.Name] {
+			continue
+		}
+		var action, as string
+		switch {
+		case named[p.Name]:
+			action, as = o.importPreset(src, p, strategy)
+		case o.Preset(p.Name) != nil && strategy != ImportSuffix:
+			action, as = "skipped, already exists, and the imported tabs use the existing one", p.Name
+		default:
+			action, as = o.importPreset(src, p, ImportSuffix)
+			action += " (used by an imported tab)"
+		}
+		renamed[p.Name] = as
+		changes = append(changes, &ImportChange{What: "preset", Name: p.Name, Action: action})
+	}
+	nextId := 0
+	for _, ts := range o.TabSetup {
+		if ts.Id >= nextId {
+			nextId = ts.Id + 1
+		}
+	}
+	for _, ts := range ex.TabSetup {
+		nts := *ts
+		nts.Id = nextId
+		nextId++
+		if as, ok := renamed[ts.Overview]; ok {
+			nts.Overview = as
+		}
+		if as, ok := renamed[string(ts.Bracket)]; ok {
+			nts.Bracket = NullableString(as)
+		}
+		o.TabSetup = append(o.TabSetup, &nts)
+		o.comments.copyFrom(src.comments, fmt.Sprintf(".tabSetup(%d)", ts.Id),
+			fmt.Sprintf(".tabSetup(%d)", nts.Id))
+		changes = append(changes, &ImportChange{What: "tab", Name: ts.Name,
+			Action: fmt.Sprintf("added as tab %d", nts.Id)})
+	}
+	return changes, nil
+}
+
+// namedPresets returns the presets named by names, each the name of a preset
+// or of a tab, which names the presets it uses.
+func (o *Overview) namedPresets(names []string) (map[string]bool, error) {
+	named := make(map[string]bool)
+	for _, name := range names {
+		found := false
+		if o.Preset(name) != nil {
+			named[name] = true
+			found = true
+		}
+		for _, ts := range o.TabSetup {
+			if ts.Name != name {
+				continue
+			}
+			found = true
+			for _, pn := range []string{ts.Overview, string(ts.Bracket)} {
+				if o.Preset(pn) != nil {
+					named[pn] = true
+				}
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("no preset or tab named %+q", name)
+		}
+	}
+	return named, nil
+}
+
+// importPreset adds p from src to o, and returns a description of what was
+// done and the name the preset ended up with.
+func (o *Overview) importPreset(src *Overview, p *Preset, strategy ImportStrategy) (string, string) {
+	np := *p
+	existing := o.Preset(p.Name)
+	switch {
+	case existing == nil:
+		o.Presets = append(o.Presets, &np)
+		o.copyPresetComments(src, p.Name, p.Name)
+		return "added", p.Name
+	case strategy == ImportOverwrite:
+		for i := range o.Presets {
+			if o.Presets[i] == existing {
+				o.Presets[i] = &np
+			}
+		}
+		o.comments.remove(".presets(" + p.Name + ")")
+		o.copyPresetComments(src, p.Name, p.Name)
+		return "overwritten", p.Name
+	case strategy == ImportSuffix:
+		np.Name = o.freePresetName(p.Name)
+		o.Presets = append(o.Presets, &np)
+		o.copyPresetComments(src, p.Name, np.Name)
+		return fmt.Sprintf("added as %+q", np.Name), np.Name
+	case strategy == ImportMergeGroups:
+		// The new groups are added after the existing ones, which are left
+		// as they are.
+		has := make(map[InvGroupId]bool)
+		for _, ig := range existing.groups() {
+			has[ig] = true
+		}
+		if existing.Groups == nil {
+			existing.Groups = &PresetGroups{}
+		}
+		added := 0
+		for _, ig := range p.groups() {
+			if !has[ig] {
+				existing.Groups.Groups = append(existing.Groups.Groups, ig)
+				has[ig] = true
+				added++
+			}
+		}
+		return fmt.Sprintf("merged, %s added", pluralise(added, "group")), p.Name
+	}
+	return "skipped, already exists", p.Name
+}
+
+// freePresetName returns name with the lowest numbered suffix that isn't the
+// name of a preset, e.g. "pvp (2)".
+func (o *Overview) freePresetName(name string) string {
+	for i := 2; ; i++ {
+		s := fmt.Sprintf("%s (%d)", name, i)
+		if o.Preset(s) == nil {
+			return s
+		}
+	}
+}
+
+// copyPresetComments copies the comments of the preset named from in src to
+// the preset named to in o.
+func (o *Overview) copyPresetComments(src *Overview, from, to string) {
+	path := ".presets(" + to + ")"
+	o.comments.copyFrom(src.comments, ".presets("+from+")", path)
+	o.comments.rename(path+"="+from, path+"="+to)
+}
+
+// copyFrom copies the comments of the node at path from in src, and of every
+// node under it, to path to.
+func (cs comments) copyFrom(src comments, from, to string) {
+	for path, nc := range src {
+		if strings.HasPrefix(path, from) {
+			cs[to+path[len(from):]] = nc
+		}
+	}
+}
+
+// remove removes the comments of the node at path, and of every node under
+// it.
+func (cs comments) remove(path string) {
+	for p := range cs {
+		if strings.HasPrefix(p, path) {
+			delete(cs, p)
+		}
+	}
+}
+
+func pluralise(n int, noun string) string {
+	if n == 1 {
+		return fmt.Sprintf("1 %s", noun)
+	}
+	return fmt.Sprintf("%d %ss", n, noun)
+}
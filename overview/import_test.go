@@ -0,0 +1,187 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overview
+
+import (
+	"reflect"
+	"testing"
+)
+
+const importSrcYAML = `presets:
+- - pvp
+  - - - groups
+      - - 25
+        - 26
+        - 28
+- - mining
+  - - - groups
+      - - 463
+tabSetup:
+- - 0
+  - - - bracket
+      - mining
+    - - name
+      - PvP
+    - - overview
+      - pvp
+`
+
+const importDstYAML = `presets:
+- - pvp
+  - - - groups
+      - - 27
+        - 26
+        - 26
+- - mining
+  - - - groups
+      - - 465
+tabSetup:
+- - 0
+  - - - name
+      - Local
+    - - overview
+      - pvp
+`
+
+func TestImport(t *testing.T) {
+	tests := []struct {
+		name     string
+		names    []string
+		tabs     bool
+		strategy ImportStrategy
+		want     []string
+		// presets are the groups of each preset afterwards.
+		presets map[string][]InvGroupId
+		// tab is the overview and bracket preset of the imported tab, if
+		// any.
+		tab []string
+	}{
+		{
+			name:    "named preset only",
+			names:   []string{"pvp"},
+			want:    []string{`preset "pvp": skipped, already exists`},
+			presets: map[string][]InvGroupId{"pvp": {27, 26, 26}, "mining": {465}},
+		},
+		{
+			name:     "tab name",
+			names:    []string{"PvP"},
+			strategy: ImportSuffix,
+			want: []string{
+				`preset "pvp": added as "pvp (2)"`,
+				`preset "mining": added as "mining (2)"`,
+			},
+			presets: map[string][]InvGroupId{"pvp": {27, 26, 26}, "mining": {465},
+				"pvp (2)": {25, 26, 28}, "mining (2)": {463}},
+		},
+		{
+			name:     "overwrite",
+			names:    []string{"pvp"},
+			strategy: ImportOverwrite,
+			want:     []string{`preset "pvp": overwritten`},
+			presets:  map[string][]InvGroupId{"pvp": {25, 26, 28}, "mining": {465}},
+		},
+		{
+			name:     "merge groups",
+			names:    []string{"pvp"},
+			strategy: ImportMergeGroups,
+			want:     []string{`preset "pvp": merged, 2 groups added`},
+			presets:  map[string][]InvGroupId{"pvp": {27, 26, 26, 25, 28}, "mining": {465}},
+		},
+		{
+			name:     "tabs don't overwrite other presets",
+			names:    []string{"pvp"},
+			tabs:     true,
+			strategy: ImportOverwrite,
+			want: []string{
+				`preset "pvp": overwritten`,
+				`preset "mining": skipped, already exists, and the imported tabs use the existing one`,
+				`tab "PvP": added as tab 1`,
+			},
+			presets: map[string][]InvGroupId{"pvp": {25, 26, 28}, "mining": {465}},
+			tab:     []string{"pvp", "mining"},
+		},
+		{
+			name:     "tabs with suffix",
+			names:    []string{"pvp"},
+			tabs:     true,
+			strategy: ImportSuffix,
+			want: []string{
+				`preset "pvp": added as "pvp (2)"`,
+				`preset "mining": added as "mining (2)" (used by an imported tab)`,
+				`tab "PvP": added as tab 1`,
+			},
+			presets: map[string][]InvGroupId{"pvp": {27, 26, 26}, "mining": {465},
+				"pvp (2)": {25, 26, 28}, "mining (2)": {463}},
+			tab: []string{"pvp (2)", "mining (2)"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			src, err := Parse([]byte(importSrcYAML))
+			if err != nil {
+				t.Fatalf("Parse: %v", err)
+			}
+			dst, err := Parse([]byte(importDstYAML))
+			if err != nil {
+				t.Fatalf("Parse: %v", err)
+			}
+			changes, err := dst.Import(src, test.names, test.tabs, test.strategy)
+			if err != nil {
+				t.Fatalf("Import: %v", err)
+			}
+			var got []string
+			for _, ic := range changes {
+				got = append(got, ic.String())
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("changes = %q, want %q", got, test.want)
+			}
+			presets := make(map[string][]InvGroupId)
+			for _, p := range dst.Presets {
+				presets[p.Name] = p.groups()
+			}
+			if !reflect.DeepEqual(presets, test.presets) {
+				t.Errorf("presets = %v, want %v", presets, test.presets)
+			}
+			if test.tab == nil {
+				if len(dst.TabSetup) != 1 {
+					t.Errorf("got %d tabs, want 1", len(dst.TabSetup))
+				}
+				return
+			}
+			if len(dst.TabSetup) != 2 {
+				t.Fatalf("got %d tabs, want 2", len(dst.TabSetup))
+			}
+			ts := dst.TabSetup[1]
+			if got := []string{ts.Overview, string(ts.Bracket)}; !reflect.DeepEqual(got, test.tab) {
+				t.Errorf("imported tab uses presets %q, want %q", got, test.tab)
+			}
+		})
+	}
+}
+
+func TestImportUnknownName(t *testing.T) {
+	src, err := Parse([]byte(importSrcYAML))
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	dst, err := Parse([]byte(importDstYAML))
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if _, err := dst.Import(src, []string{"nope"}, false, ImportSkip); err == nil {
+		t.Errorf("Import of an unknown name succeeded")
+	}
+}
@@ -0,0 +1,423 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overview
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Rule is a check run over a parsed overview by Lint.
+type Rule struct {
+	// ID identifies the rule in diagnostics, lint configs and inline
+	// directives, e.g. "duplicate-group".
+	ID       string
+	Severity Severity
+	// Desc is a one-line description of what the rule checks.
+	Desc  string
+	Check func(o *Overview, c *Catalog, report Report)
+}
+
+// Report is called by a rule for every problem it finds. path identifies the
+// element the problem is with, e.g. "presets[4].groups[12]".
+type Report func(path, format string, a ...interface{})
+
+var rules = make(map[string]*Rule)
+
+// RegisterRule adds a rule to the ones run by Lint. It panics if a rule with
+// the same ID is already registered.
+func RegisterRule(r *Rule) {
+	if _, ok := rules[r.ID]; ok {
+		panic(fmt.Sprintf("lint rule %+q registered twice", r.ID))
+	}
+	rules[r.ID] = r
+}
+
+// Rules returns the registered rules, ordered by ID.
+func Rules() []*Rule {
+	out := make([]*Rule, 0, len(rules))
+	for _, r := range rules {
+		out = append(out, r)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	return out
+}
+
+// LintConfig controls which rules Lint runs.
+type LintConfig struct {
+	// Disable lists the IDs of rules not to run.
+	Disable []string `yaml:"disable"`
+	// Severity overrides the severity of rules, by ID. Values are "error" or
+	// "warning".
+	Severity map[string]string `yaml:"severity"`
+}
+
+// ParseLintConfig parses a yaml lint config, e.g.:
+//
+//	disable: [empty-preset]
+//	severity:
+//	  unknown-group: error
+func ParseLintConfig(b []byte) (*LintConfig, error) {
+	cfg := &LintConfig{}
+	if err := yaml.Unmarshal(b, cfg); err != nil {
+		return nil, err
+	}
+	for _, id := range cfg.Disable {
+		if _, ok := rules[id]; !ok {
+			return nil, fmt.Errorf("unknown lint rule %+q", id)
+		}
+	}
+	for id, sev := range cfg.Severity {
+		if _, ok := rules[id]; !ok {
+			return nil, fmt.Errorf("unknown lint rule %+q", id)
+		}
+		if _, err := ParseSeverity(sev); err != nil {
+			return nil, fmt.Errorf("lint rule %+q: %v", id, err)
+		}
+	}
+	return cfg, nil
+}
+
+// Lint runs the registered rules over o, and returns the problems found,
+// ordered by their position in the file. cfg may be nil, to run every rule.
+// Rules can also be disabled by a comment in the file containing
+// "eot:disable", optionally followed by a list of rule IDs. The comment
+// applies to the element it's attached to, or to the whole file if it's at
+// the top of the file (followed by a blank line).
+func Lint(o *Overview, c *Catalog, cfg *LintConfig) Diagnostics {
+	if cfg == nil {
+		cfg = &LintConfig{}
+	}
+	var diags Diagnostics
+	for _, r := range Rules() {
+		if isKnown(r.ID, cfg.Disable) {
+			continue
+		}
+		sev := r.Severity
+		if s, ok := cfg.Severity[r.ID]; ok {
+			sev, _ = ParseSeverity(s)
+		}
+		r.Check(o, c, func(path, format string, a ...interface{}) {
+			diag := o.diagf(sev, path, format, a...)
+			diag.Rule = r.ID
+			if !o.directives.disabled(r.ID, diag.Pos.Line) {
+				diags = append(diags, diag)
+			}
+		})
+	}
+	diags.sort()
+	return diags
+}
+
+// disableRx matches an inline lint directive, e.g.
+// "eot:disable duplicate-group, empty-preset".
+var disableRx = regexp.MustCompile(`eot:disable((?:[ \t,]+[a-z0-9-]+)*)`)
+
+// directive disables lint rules for a range of lines.
+type directive struct {
+	// rules are the IDs of the disabled rules, or empty for all of them.
+	rules []string
+	// from and to are the first and last lines the directive applies to. If
+	// to is 0, it applies to the rest of the file.
+	from, to int
+}
+
+type directives []directive
+
+func (ds directives) disabled(id string, line int) bool {
+	for _, d := range ds {
+		if line < d.from || (d.to > 0 && line > d.to) {
+			continue
+		}
+		if len(d.rules) == 0 || isKnown(id, d.rules) {
+			return true
+		}
+	}
+	return false
+}
+
+// collectDirectives finds the inline lint directives in a parsed document.
+func collectDirectives(doc *yaml.Node) directives {
+	var ds directives
+	add := func(comments []string, from, to int) {
+		for _, comment := range comments {
+			for _, m := range disableRx.FindAllStringSubmatch(comment, -1) {
+				ds = append(ds, directive{rules: strings.FieldsFunc(m[1], func(r rune) bool {
+					return r == ' ' || r == '\t' || r == ','
+				}), from: from, to: to})
+			}
+		}
+	}
+	var walk func(n *yaml.Node)
+	walk = func(n *yaml.Node) {
+		switch n.Kind {
+		case yaml.DocumentNode:
+			add([]string{n.HeadComment}, 0, 0)
+		case yaml.MappingNode:
+			for i := 0; i+1 < len(n.Content); i += 2 {
+				// Comments on a key apply to its value too.
+				k, v := n.Content[i], n.Content[i+1]
+				add([]string{k.HeadComment, k.LineComment}, k.Line, endLine(v))
+			}
+		case yaml.SequenceNode:
+			if isPair(n) {
+				// Likewise for the name of a [name, value] pair, e.g.
+				// "- - filteredStates # eot:disable".
+				k := n.Content[0]
+				add([]string{k.HeadComment, k.LineComment}, k.Line, endLine(n))
+			}
+		}
+		if n.Kind != yaml.DocumentNode {
+			add([]string{n.HeadComment, n.LineComment}, n.Line, endLine(n))
+		}
+		for _, c := range n.Content {
+			walk(c)
+		}
+	}
+	walk(doc)
+	return ds
+}
+
+// endLine returns the last line of a node and its contents.
+func endLine(n *yaml.Node) int {
+	line := n.Line
+	for _, c := range n.Content {
+		if l := endLine(c); l > line {
+			line = l
+		}
+	}
+	return line
+}
+
+func init() {
+	for _, r := range []*Rule{
+		{
+			ID:       "shown-and-filtered",
+			Severity: SevWarning,
+			Desc:     "A state is in both the alwaysShownStates and filteredStates of a preset.",
+			Check:    checkShownAndFiltered,
+		},
+		{
+			ID:       "duplicate-group",
+			Severity: SevWarning,
+			Desc:     "A group is listed more than once in a preset.",
+			Check:    checkDuplicateGroups,
+		},
+		{
+			ID:       "duplicate-state",
+			Severity: SevWarning,
+			Desc:     "A state is listed more than once in a preset or state list.",
+			Check:    checkDuplicateStates,
+		},
+		{
+			ID:       "unknown-group",
+			Severity: SevWarning,
+			Desc:     "A preset has a group that isn't in the inventory groups data.",
+			Check:    checkUnknownGroups,
+		},
+		{
+			ID:       "unknown-state",
+			Severity: SevWarning,
+			Desc:     "A state isn't in the filter states data.",
+			Check:    checkUnknownStates,
+		},
+		{
+			ID:       "empty-preset",
+			Severity: SevWarning,
+			Desc:     "A preset has no groups, so shows nothing.",
+			Check:    checkEmptyPresets,
+		},
+		{
+			ID:       "duplicate-preset",
+			Severity: SevError,
+			Desc:     "More than one preset has the same name.",
+			Check:    checkDuplicatePresets,
+		},
+		{
+			ID:       "duplicate-tab",
+			Severity: SevError,
+			Desc:     "More than one tab has the same ID.",
+			Check:    checkDuplicateTabs,
+		},
+		{
+			ID:       "missing-preset",
+			Severity: SevError,
+			Desc:     "A tab's overview or bracket preset doesn't exist.",
+			Check:    checkMissingPresets,
+		},
+		{
+			ID:       "unknown-column",
+			Severity: SevWarning,
+			Desc:     "A column isn't in the overview columns data.",
+			Check:    checkUnknownColumns,
+		},
+		{
+			ID:       "duplicate-column",
+			Severity: SevWarning,
+			Desc:     "A column is listed more than once.",
+			Check:    checkDuplicateColumns,
+		},
+		{
+			ID:       "unordered-column",
+			Severity: SevWarning,
+			Desc:     "An enabled column is missing from columnOrder.",
+			Check:    checkUnorderedColumns,
+		},
+	} {
+		RegisterRule(r)
+	}
+}
+
+func checkShownAndFiltered(o *Overview, c *Catalog, report Report) {
+	for i, p := range o.Presets {
+		shown := make(map[StateType]bool)
+		for _, st := range p.alwaysShown() {
+			shown[st] = true
+		}
+		for j, st := range p.filtered() {
+			if shown[st] {
+				report(elemPath(elemPath("presets", i)+".filteredStates", j),
+					"state %s is in both alwaysShownStates and filteredStates", c.State(st))
+			}
+		}
+	}
+}
+
+func checkDuplicateGroups(o *Overview, c *Catalog, report Report) {
+	for i, p := range o.Presets {
+		seen := make(map[InvGroupId]bool)
+		for j, ig := range p.groups() {
+			if seen[ig] {
+				report(elemPath(elemPath("presets", i)+".groups", j), "duplicate group %s", c.Group(ig))
+			}
+			seen[ig] = true
+		}
+	}
+}
+
+// stateLists calls f with every list of states in o, along with its path.
+func (o *Overview) stateLists(f func(path string, sts []StateType)) {
+	for i, p := range o.Presets {
+		f(elemPath("presets", i)+".alwaysShownStates", p.alwaysShown())
+		f(elemPath("presets", i)+".filteredStates", p.filtered())
+	}
+	f("backgroundOrder", o.BackgroundOrder)
+	f("backgroundStates", o.BackgroundStates)
+	f("flagOrder", o.FlagOrder)
+	f("flagStates", o.FlagStates)
+}
+
+func checkDuplicateStates(o *Overview, c *Catalog, report Report) {
+	o.stateLists(func(path string, sts []StateType) {
+		seen := make(map[StateType]bool)
+		for j, st := range sts {
+			if seen[st] {
+				report(elemPath(path, j), "duplicate state %s", c.State(st))
+			}
+			seen[st] = true
+		}
+	})
+}
+
+func checkUnknownGroups(o *Overview, c *Catalog, report Report) {
+	for i, p := range o.Presets {
+		for j, ig := range p.groups() {
+			if _, ok := c.Groups[ig]; !ok {
+				report(elemPath(elemPath("presets", i)+".groups", j), "unknown group %d", int(ig))
+			}
+		}
+	}
+}
+
+func checkUnknownStates(o *Overview, c *Catalog, report Report) {
+	o.stateLists(func(path string, sts []StateType) {
+		for j, st := range sts {
+			if _, ok := c.States[st]; !ok {
+				report(elemPath(path, j), "unknown state %d", int(st))
+			}
+		}
+	})
+	check := func(section string, i int, name string) {
+		m := stateNameRx.FindStringSubmatch(name)
+		if m == nil {
+			return
+		}
+		var st StateType
+		fmt.Sscan(m[2], &st)
+		if _, ok := c.States[st]; !ok {
+			report(elemPath(section, i), "unknown state %d in %+q", int(st), name)
+		}
+	}
+	for i, sb := range o.StateBlinks {
+		check("stateBlinks", i, sb.Name)
+	}
+	for i, sc := range o.StateColorsNameList {
+		check("stateColorsNameList", i, sc.Name)
+	}
+}
+
+func checkEmptyPresets(o *Overview, c *Catalog, report Report) {
+	for i, p := range o.Presets {
+		if len(p.groups()) == 0 {
+			report(elemPath("presets", i), "preset %+q has no groups", p.Name)
+		}
+	}
+}
+
+func checkDuplicatePresets(o *Overview, c *Catalog, report Report) {
+	seen := make(map[string]bool)
+	for i, p := range o.Presets {
+		if seen[p.Name] {
+			report(elemPath("presets", i), "duplicate preset %+q", p.Name)
+		}
+		seen[p.Name] = true
+	}
+}
+
+func checkDuplicateTabs(o *Overview, c *Catalog, report Report) {
+	seen := make(map[int]bool)
+	for i, ts := range o.TabSetup {
+		if seen[ts.Id] {
+			report(elemPath("tabSetup", i), "duplicate tab %d", ts.Id)
+		}
+		seen[ts.Id] = true
+	}
+}
+
+func checkMissingPresets(o *Overview, c *Catalog, report Report) {
+	for i, ts := range o.TabSetup {
+		path := elemPath("tabSetup", i)
+		if o.Preset(ts.Overview) == nil {
+			report(path+".overview", "tab %d (%s) overview preset %+q doesn't exist", ts.Id, ts.Name, ts.Overview)
+		}
+		if ts.Bracket != "" && o.Preset(string(ts.Bracket)) == nil {
+			report(path+".bracket", "tab %d (%s) bracket preset %+q doesn't exist", ts.Id, ts.Name, ts.Bracket)
+		}
+	}
+}
+
+// Preset returns the preset with the given name, or nil if there isn't one.
+func (o *Overview) Preset(name string) *Preset {
+	for _, p := range o.Presets {
+		if p.Name == name {
+			return p
+		}
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overview
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestRules(t *testing.T) {
+	var ids []string
+	for _, r := range Rules() {
+		ids = append(ids, r.ID)
+		if r.Desc == "" || r.Check == nil {
+			t.Errorf("rule %+q has no description or check", r.ID)
+		}
+	}
+	if !sort.StringsAreSorted(ids) {
+		t.Errorf("Rules() not ordered by ID: %q", ids)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("registering a rule twice didn't panic")
+		}
+	}()
+	RegisterRule(&Rule{ID: "duplicate-group"})
+}
+
+func TestParseLintConfig(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		ok   bool
+	}{
+		{"valid", "disable: [empty-preset]\nseverity:\n  unknown-group: error\n", true},
+		{"unknown disabled rule", "disable: [no-such-rule]\n", false},
+		{"unknown severity rule", "severity:\n  no-such-rule: error\n", false},
+		{"bad severity", "severity:\n  unknown-group: fatal\n", false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := ParseLintConfig([]byte(test.in)); (err == nil) != test.ok {
+				t.Errorf("ParseLintConfig error = %v, want ok = %v", err, test.ok)
+			}
+		})
+	}
+}
+
+func TestLint(t *testing.T) {
+	c := testCatalog(t)
+	// Group 25 is duplicated, and state 11 is both always shown and
+	// filtered.
+	preset := `presets:
+- - pvp
+  - - - alwaysShownStates
+      - - 11
+    - - filteredStates%s
+      - - 11
+    - - groups
+      - - 25
+        - 25%s
+`
+	tests := []struct {
+		name string
+		in   string
+		cfg  *LintConfig
+		want []string
+	}{
+		{
+			name: "no directives",
+			in:   fmt.Sprintf(preset, "", ""),
+			want: []string{"duplicate-group", "shown-and-filtered"},
+		},
+		{
+			name: "config disables rule",
+			in:   fmt.Sprintf(preset, "", ""),
+			cfg:  &LintConfig{Disable: []string{"duplicate-group"}},
+			want: []string{"shown-and-filtered"},
+		},
+		{
+			name: "disable all at top of file",
+			in:   "# eot:disable\n\n" + fmt.Sprintf(preset, "", ""),
+		},
+		{
+			name: "disable one at top of file",
+			in:   "# eot:disable duplicate-group\n\n" + fmt.Sprintf(preset, "", ""),
+			want: []string{"shown-and-filtered"},
+		},
+		{
+			name: "disable on element",
+			in:   fmt.Sprintf(preset, "", " # eot:disable duplicate-group"),
+			want: []string{"shown-and-filtered"},
+		},
+		{
+			name: "disable all on element",
+			in:   fmt.Sprintf(preset, "", " # eot:disable"),
+			want: []string{"shown-and-filtered"},
+		},
+		{
+			name: "disable on attribute name",
+			in:   fmt.Sprintf(preset, " # eot:disable shown-and-filtered", ""),
+			want: []string{"duplicate-group"},
+		},
+		{
+			name: "other rule disabled on attribute name",
+			in:   fmt.Sprintf(preset, " # eot:disable empty-preset", ""),
+			want: []string{"duplicate-group", "shown-and-filtered"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			o, err := Parse([]byte(test.in))
+			if err != nil {
+				t.Fatalf("Parse: %v", err)
+			}
+			var got []string
+			for _, d := range Lint(o, c, test.cfg) {
+				got = append(got, d.Rule)
+			}
+			sort.Strings(got)
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("rules reported = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
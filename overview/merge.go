@@ -0,0 +1,567 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overview
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Conflict is an element that both sides of a merge changed differently.
+// The merged overview keeps our version of it.
+type Conflict struct {
+	// Path identifies the element, e.g. "tabSetup(0).overview".
+	Path   string `json:"path"`
+	Base   string `json:"base"`
+	Ours   string `json:"ours"`
+	Theirs string `json:"theirs"`
+	// comment is the comment path of the node to mark in the merged file.
+	comment string
+}
+
+func (c *Conflict) String() string {
+	return fmt.Sprintf("%s: ours %s, theirs %s (base %s)", c.Path, c.Ours, c.Theirs, c.Base)
+}
+
+// Merge does a three-way merge of two overviews, ours and theirs, that were
+// both derived from base. Preset groups and states, enabled columns and
+// background/flag states are merged as sets, so changes to them never
+// conflict. Presets, tabs, ship labels, user settings and the per-state
+// colours and blinks are merged per key and attribute, and the remaining
+// ordered lists as a whole. The result keeps the comments and layout of
+// ours, along with our version of any conflicting element.
+func Merge(base, ours, theirs *Overview) (*Overview, []*Conflict) {
+	m := &merger{}
+	o := *ours
+	o.comments = make(comments, len(ours.comments))
+	for path, nc := range ours.comments {
+		o.comments[path] = nc
+	}
+	o.Presets = m.presets(base.Presets, ours.Presets, theirs.Presets)
+	o.TabSetup = m.tabs(base.TabSetup, ours.TabSetup, theirs.TabSetup)
+	o.ShipLabels = m.shipLabels(base.ShipLabels, ours.ShipLabels, theirs.ShipLabels)
+	o.ShipLabelOrder = m.value("shipLabelOrder", ".shipLabelOrder:",
+		base.ShipLabelOrder, ours.ShipLabelOrder, theirs.ShipLabelOrder).([]NullableString)
+	o.StateBlinks = m.stateBlinks(base.StateBlinks, ours.StateBlinks, theirs.StateBlinks)
+	o.StateColorsNameList = m.stateColors(base.StateColorsNameList, ours.StateColorsNameList,
+		theirs.StateColorsNameList)
+	o.UserSettings = m.userSettings(base.UserSettings, ours.UserSettings, theirs.UserSettings)
+	o.ColumnOrder = m.value("columnOrder", ".columnOrder:",
+		base.ColumnOrder, ours.ColumnOrder, theirs.ColumnOrder).([]Column)
+	o.OverviewColumns = mergeSet(base.OverviewColumns, ours.OverviewColumns,
+		theirs.OverviewColumns).([]Column)
+	o.BackgroundOrder = m.value("backgroundOrder", ".backgroundOrder:",
+		base.BackgroundOrder, ours.BackgroundOrder, theirs.BackgroundOrder).([]StateType)
+	o.BackgroundStates = mergeSet(base.BackgroundStates, ours.BackgroundStates,
+		theirs.BackgroundStates).([]StateType)
+	o.FlagOrder = m.value("flagOrder", ".flagOrder:",
+		base.FlagOrder, ours.FlagOrder, theirs.FlagOrder).([]StateType)
+	o.FlagStates = mergeSet(base.FlagStates, ours.FlagStates, theirs.FlagStates).([]StateType)
+	o.Extra = m.raw(base.Extra, ours.Extra, theirs.Extra, func(name string) (string, string) {
+		return name, "." + name + ":"
+	})
+	return &o, m.conflicts
+}
+
+// MarkConflicts adds a comment to the element of each conflict, so they can
+// be found and resolved by hand in the marshalled overview.
+func (o *Overview) MarkConflicts(cs []*Conflict) {
+	if o.comments == nil {
+		o.comments = make(comments)
+	}
+	for _, c := range cs {
+		marker := fmt.Sprintf("# CONFLICT: ours %s, theirs %s (base %s)", c.Ours, c.Theirs, c.Base)
+		nc := &nodeComments{head: marker}
+		if old, ok := o.comments[c.comment]; ok {
+			nc.line, nc.foot = old.line, old.foot
+			if old.head != "" {
+				nc.head = old.head + "\n" + marker
+			}
+		}
+		o.comments[c.comment] = nc
+	}
+}
+
+type merger struct {
+	conflicts []*Conflict
+}
+
+func (m *merger) conflict(path, comment string, base, ours, theirs interface{}) {
+	m.conflicts = append(m.conflicts, &Conflict{
+		Path: path, Base: mergeValue(base), Ours: mergeValue(ours), Theirs: mergeValue(theirs),
+		comment: comment,
+	})
+}
+
+// entryState describes the state of a whole entry in a conflict report, e.g.
+// "(deleted)".
+type entryState string
+
+// mergeValue formats a value for a conflict report.
+func mergeValue(v interface{}) string {
+	if es, ok := v.(entryState); ok {
+		return string(es)
+	}
+	rv := reflect.ValueOf(v)
+	switch {
+	case !rv.IsValid():
+		return "(absent)"
+	case rv.Kind() == reflect.Ptr:
+		if rv.IsNil() {
+			return "(unset)"
+		}
+		return mergeValue(rv.Elem().Interface())
+	case rv.Kind() == reflect.String:
+		return fmt.Sprintf("%+q", rv.String())
+	case rv.Kind() == reflect.Slice:
+		vals := make([]string, rv.Len())
+		for i := range vals {
+			vals[i] = fmt.Sprint(rv.Index(i).Interface())
+		}
+		return "[" + strings.Join(vals, ", ") + "]"
+	}
+	return fmt.Sprint(v)
+}
+
+// same returns true if a and b are equal, treating nil and empty slices
+// alike.
+func same(a, b interface{}) bool {
+	ra, rb := reflect.ValueOf(a), reflect.ValueOf(b)
+	if ra.Kind() == reflect.Slice && rb.Kind() == reflect.Slice && ra.Len() == 0 && rb.Len() == 0 {
+		return true
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// value merges a single value: if only one side changed it, that side wins.
+// If both changed it differently, a conflict is recorded and ours is kept.
+func (m *merger) value(path, comment string, base, ours, theirs interface{}) interface{} {
+	switch {
+	case same(ours, theirs), same(theirs, base):
+		return ours
+	case same(ours, base):
+		return theirs
+	}
+	m.conflict(path, comment, base, ours, theirs)
+	return ours
+}
+
+// mergeSet merges three versions of a list with set semantics: the result is
+// ours, plus whatever theirs added to base, minus whatever theirs removed
+// from it. The lists must all be slices of the same type.
+func mergeSet(base, ours, theirs interface{}) interface{} {
+	keys := func(rv reflect.Value) map[string]bool {
+		m := make(map[string]bool, rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			m[fmt.Sprint(rv.Index(i).Interface())] = true
+		}
+		return m
+	}
+	rb, ro, rt := reflect.ValueOf(base), reflect.ValueOf(ours), reflect.ValueOf(theirs)
+	inBase, inOurs, inTheirs := keys(rb), keys(ro), keys(rt)
+	out := reflect.MakeSlice(ro.Type(), 0, ro.Len())
+	for i := 0; i < ro.Len(); i++ {
+		k := fmt.Sprint(ro.Index(i).Interface())
+		if inBase[k] && !inTheirs[k] {
+			continue
+		}
+		out = reflect.Append(out, ro.Index(i))
+	}
+	for i := 0; i < rt.Len(); i++ {
+		k := fmt.Sprint(rt.Index(i).Interface())
+		if !inBase[k] && !inOurs[k] {
+			out = reflect.Append(out, rt.Index(i))
+			inOurs[k] = true
+		}
+	}
+	if out.Len() == 0 && ro.IsNil() {
+		return ours
+	}
+	return out.Interface()
+}
+
+// listPath returns the paths of the entries of a list section, e.g.
+// "tabSetup(0)".
+func listPath(section string) func(string) (string, string) {
+	return func(key string) (string, string) {
+		path := section + "(" + key + ")"
+		return path, "." + path
+	}
+}
+
+// attrPath returns the paths of the attributes of a [name, attributes] entry,
+// e.g. "tabSetup(0).overview".
+func attrPath(entry string) func(string) (string, string) {
+	return func(name string) (string, string) {
+		return entry + "." + name, "." + entry + "[1](" + name + ")"
+	}
+}
+
+// keyedEntry is an entry of a merged keyed list, given as its index in each
+// of the base, our and their lists, or -1 if it isn't in that list.
+type keyedEntry struct {
+	key     string
+	b, o, t int
+}
+
+// keyed merges three versions of a list of entries identified by key, and
+// returns the entries of the result: ours, followed by the ones only theirs
+// added. An entry deleted on one side is dropped if the other side left it
+// unchanged, as reported by unchanged (called with the index of the entry in
+// base, and in ours or theirs). If the other side changed it, a conflict is
+// recorded and the changed entry is kept. path returns the path and comment
+// path of an entry.
+func (m *merger) keyed(base, ours, theirs []string, path func(key string) (string, string),
+	unchanged func(b, i int, theirs bool) bool) []keyedEntry {
+	index := func(keys []string) map[string]int {
+		keys = uniqueKeys(keys)
+		idx := make(map[string]int, len(keys))
+		for i, k := range keys {
+			idx[k] = i
+		}
+		return idx
+	}
+	find := func(idx map[string]int, k string) int {
+		if i, ok := idx[k]; ok {
+			return i
+		}
+		return -1
+	}
+	bIdx, oIdx, tIdx := index(base), index(ours), index(theirs)
+	var out []keyedEntry
+	add := func(k string) {
+		e := keyedEntry{key: k, b: find(bIdx, k), o: find(oIdx, k), t: find(tIdx, k)}
+		path, comment := path(k)
+		switch {
+		case e.b < 0 || (e.o >= 0 && e.t >= 0):
+		case e.t < 0:
+			// Deleted by them.
+			if unchanged(e.b, e.o, false) {
+				return
+			}
+			m.conflict(path, comment, entryState("(present)"), entryState("(modified)"),
+				entryState("(deleted)"))
+		case e.o < 0:
+			// Deleted by us.
+			if unchanged(e.b, e.t, true) {
+				return
+			}
+			m.conflict(path, comment, entryState("(present)"), entryState("(deleted)"),
+				entryState("(modified)"))
+		}
+		out = append(out, e)
+	}
+	for _, k := range uniqueKeys(ours) {
+		add(k)
+	}
+	for _, k := range uniqueKeys(theirs) {
+		if _, ok := oIdx[k]; !ok {
+			add(k)
+		}
+	}
+	return out
+}
+
+func (m *merger) presets(base, ours, theirs []*Preset) []*Preset {
+	names := func(ps []*Preset) []string {
+		out := make([]string, len(ps))
+		for i, p := range ps {
+			out[i] = p.Name
+		}
+		return out
+	}
+	sides := [][]*Preset{ours, theirs}
+	unchanged := func(b, i int, isTheirs bool) bool {
+		side := sides[0]
+		if isTheirs {
+			side = sides[1]
+		}
+		p := side[i]
+		return diffPresets([]*Preset{base[b]}, []*Preset{p}, &Catalog{}) == nil
+	}
+	var out []*Preset
+	for _, e := range m.keyed(names(base), names(ours), names(theirs), listPath("presets"), unchanged) {
+		switch {
+		case e.o < 0:
+			out = append(out, theirs[e.t])
+		case e.t < 0:
+			out = append(out, ours[e.o])
+		default:
+			var b *Preset
+			if e.b >= 0 {
+				b = base[e.b]
+			}
+			out = append(out, m.preset("presets("+e.key+")", b, ours[e.o], theirs[e.t]))
+		}
+	}
+	return out
+}
+
+func (m *merger) preset(path string, b, o, t *Preset) *Preset {
+	p := *o
+	if groups := mergeSet(b.groups(), o.groups(), t.groups()).([]InvGroupId); groups != nil || t.Groups != nil {
+		p.Groups = &PresetGroups{Groups: groups}
+	}
+	p.AlwaysShownStates = mergePresetStates("alwaysShownStates", b.alwaysShown(), o.AlwaysShownStates,
+		t.AlwaysShownStates)
+	p.FilteredStates = mergePresetStates("filteredStates", b.filtered(), o.FilteredStates,
+		t.FilteredStates)
+	p.Extra = m.raw(b.extra(), o.Extra, t.Extra, attrPath(path))
+	return &p
+}
+
+func mergePresetStates(name string, base []StateType, o, t *PresetStates) *PresetStates {
+	if o == nil && t == nil {
+		return nil
+	}
+	var ours, theirs []StateType
+	if o != nil {
+		ours = o.States
+	}
+	if t != nil {
+		theirs = t.States
+	}
+	return &PresetStates{Name: name, States: mergeSet(base, ours, theirs).([]StateType)}
+}
+
+// raw merges the attributes this package doesn't know about, by name. path
+// returns the path and comment path of an attribute.
+func (m *merger) raw(base, ours, theirs []RawAttr, path func(name string) (string, string)) []RawAttr {
+	names := func(ras []RawAttr) []string {
+		out := make([]string, len(ras))
+		for i, ra := range ras {
+			out[i] = ra.Name
+		}
+		return out
+	}
+	unchanged := func(b, i int, isTheirs bool) bool {
+		side := ours
+		if isTheirs {
+			side = theirs
+		}
+		return same(rawValue(base[b].Value), rawValue(side[i].Value))
+	}
+	var out []RawAttr
+	for _, e := range m.keyed(names(base), names(ours), names(theirs), path, unchanged) {
+		switch {
+		case e.o < 0:
+			out = append(out, theirs[e.t])
+		case e.t < 0:
+			out = append(out, ours[e.o])
+		default:
+			var b interface{}
+			if e.b >= 0 {
+				b = rawValue(base[e.b].Value)
+			}
+			o, t := rawValue(ours[e.o].Value), rawValue(theirs[e.t].Value)
+			attrPath, comment := path(e.key)
+			if same(m.value(attrPath, comment, b, o, t), o) {
+				out = append(out, ours[e.o])
+			} else {
+				out = append(out, theirs[e.t])
+			}
+		}
+	}
+	return out
+}
+
+func (m *merger) tabs(base, ours, theirs []*TabSetup) []*TabSetup {
+	keys := func(tss []*TabSetup) []string {
+		out := make([]string, len(tss))
+		for i, ts := range tss {
+			out[i] = strconv.Itoa(ts.Id)
+		}
+		return out
+	}
+	unchanged := func(b, i int, isTheirs bool) bool {
+		side := ours
+		if isTheirs {
+			side = theirs
+		}
+		return same(tabEntries(base[b:b+1]), tabEntries(side[i:i+1]))
+	}
+	var out []*TabSetup
+	for _, e := range m.keyed(keys(base), keys(ours), keys(theirs), listPath("tabSetup"), unchanged) {
+		switch {
+		case e.o < 0:
+			out = append(out, theirs[e.t])
+		case e.t < 0:
+			out = append(out, ours[e.o])
+		default:
+			b := &TabSetup{}
+			if e.b >= 0 {
+				b = base[e.b]
+			}
+			out = append(out, m.tab("tabSetup("+e.key+")", b, ours[e.o], theirs[e.t]))
+		}
+	}
+	return out
+}
+
+func (m *merger) tab(path string, b, o, t *TabSetup) *TabSetup {
+	ts := *o
+	paths := attrPath(path)
+	attr := func(name string, bv, ov, tv interface{}) interface{} {
+		p, comment := paths(name)
+		return m.value(p, comment, bv, ov, tv)
+	}
+	ts.Bracket = attr("bracket", b.Bracket, o.Bracket, t.Bracket).(NullableString)
+	ts.Name = attr("name", b.Name, o.Name, t.Name).(string)
+	ts.Overview = attr("overview", b.Overview, o.Overview, t.Overview).(string)
+	ts.ShowAll = attr("showAll", b.ShowAll, o.ShowAll, t.ShowAll).(*bool)
+	ts.ShowNone = attr("showNone", b.ShowNone, o.ShowNone, t.ShowNone).(*bool)
+	ts.ShowSpecials = attr("showSpecials", b.ShowSpecials, o.ShowSpecials, t.ShowSpecials).(*bool)
+	ts.Extra = m.raw(b.Extra, o.Extra, t.Extra, paths)
+	return &ts
+}
+
+func (m *merger) shipLabels(base, ours, theirs []*ShipLabel) []*ShipLabel {
+	keys := func(sls []*ShipLabel) []string {
+		out := make([]string, len(sls))
+		for i, sl := range sls {
+			out[i] = sl.Name.key()
+		}
+		return out
+	}
+	unchanged := func(b, i int, isTheirs bool) bool {
+		side := ours
+		if isTheirs {
+			side = theirs
+		}
+		return *base[b] == *side[i]
+	}
+	var out []*ShipLabel
+	for _, e := range m.keyed(keys(base), keys(ours), keys(theirs), listPath("shipLabels"), unchanged) {
+		switch {
+		case e.o < 0:
+			out = append(out, theirs[e.t])
+		case e.t < 0:
+			out = append(out, ours[e.o])
+		default:
+			b := &ShipLabel{}
+			if e.b >= 0 {
+				b = base[e.b]
+			}
+			o, t := ours[e.o], theirs[e.t]
+			paths := attrPath("shipLabels(" + e.key + ")")
+			attr := func(name string, bv, ov, tv interface{}) interface{} {
+				p, comment := paths(name)
+				return m.value(p, comment, bv, ov, tv)
+			}
+			sl := *o
+			sl.Post = attr("post", b.Post, o.Post, t.Post).(string)
+			sl.Pre = attr("pre", b.Pre, o.Pre, t.Pre).(string)
+			sl.State = attr("state", b.State, o.State, t.State).(ShipLabelState)
+			sl.Type = attr("type", b.Type, o.Type, t.Type).(NullableString)
+			out = append(out, &sl)
+		}
+	}
+	return out
+}
+
+// namedValue is one of the [name, value] pairs that make up the user
+// settings and per-state settings.
+type namedValue struct {
+	name string
+	val  interface{}
+}
+
+// pairs merges three versions of a list of [name, value] pairs by name.
+func (m *merger) pairs(section string, base, ours, theirs []namedValue) []namedValue {
+	names := func(nvs []namedValue) []string {
+		out := make([]string, len(nvs))
+		for i, nv := range nvs {
+			out[i] = nv.name
+		}
+		return out
+	}
+	unchanged := func(b, i int, isTheirs bool) bool {
+		side := ours
+		if isTheirs {
+			side = theirs
+		}
+		return same(base[b].val, side[i].val)
+	}
+	var out []namedValue
+	for _, e := range m.keyed(names(base), names(ours), names(theirs), listPath(section), unchanged) {
+		switch {
+		case e.o < 0:
+			out = append(out, theirs[e.t])
+		case e.t < 0:
+			out = append(out, ours[e.o])
+		default:
+			var b interface{}
+			if e.b >= 0 {
+				b = base[e.b].val
+			}
+			path := section + "(" + e.key + ")"
+			v := m.value(path, "."+path, b, ours[e.o].val, theirs[e.t].val)
+			out = append(out, namedValue{name: ours[e.o].name, val: v})
+		}
+	}
+	return out
+}
+
+func blinkValues(sbs []*StateBlink) []namedValue {
+	out := make([]namedValue, len(sbs))
+	for i, sb := range sbs {
+		out[i] = namedValue{sb.Name, sb.Val}
+	}
+	return out
+}
+
+func (m *merger) stateBlinks(base, ours, theirs []*StateBlink) []*StateBlink {
+	var out []*StateBlink
+	for _, nv := range m.pairs("stateBlinks", blinkValues(base), blinkValues(ours), blinkValues(theirs)) {
+		out = append(out, &StateBlink{Name: nv.name, Val: nv.val.(bool)})
+	}
+	return out
+}
+
+func colorValues(scs []*StateColorName) []namedValue {
+	out := make([]namedValue, len(scs))
+	for i, sc := range scs {
+		out[i] = namedValue{sc.Name, sc.Val}
+	}
+	return out
+}
+
+func (m *merger) stateColors(base, ours, theirs []*StateColorName) []*StateColorName {
+	var out []*StateColorName
+	for _, nv := range m.pairs("stateColorsNameList", colorValues(base), colorValues(ours),
+		colorValues(theirs)) {
+		out = append(out, &StateColorName{Name: nv.name, Val: nv.val.(string)})
+	}
+	return out
+}
+
+func settingValues(uss []*UserSetting) []namedValue {
+	out := make([]namedValue, len(uss))
+	for i, us := range uss {
+		out[i] = namedValue{us.Name, us.Val}
+	}
+	return out
+}
+
+func (m *merger) userSettings(base, ours, theirs []*UserSetting) []*UserSetting {
+	var out []*UserSetting
+	for _, nv := range m.pairs("userSettings", settingValues(base), settingValues(ours),
+		settingValues(theirs)) {
+		out = append(out, &UserSetting{Name: nv.name, Val: nv.val.(bool)})
+	}
+	return out
+}
@@ -0,0 +1,201 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overview
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const mergeBaseYAML = `columnOrder:
+- ICON
+- DISTANCE
+presets:
+- - pvp
+  - - - groups
+      - - 25
+        - 26
+- - mining
+  - - - groups
+      - - 463
+tabSetup:
+- - 0
+  - - - name
+      - PvP
+    - - overview
+      - pvp
+`
+
+// mergeEdit returns mergeBaseYAML with each old string replaced by the
+// following new one.
+func mergeEdit(t *testing.T, oldnew ...string) string {
+	t.Helper()
+	s := mergeBaseYAML
+	for i := 0; i+1 < len(oldnew); i += 2 {
+		if !strings.Contains(s, oldnew[i]) {
+			t.Fatalf("%q not in the base file", oldnew[i])
+		}
+		s = strings.Replace(s, oldnew[i], oldnew[i+1], 1)
+	}
+	return s
+}
+
+func mustParse(t *testing.T, s string) *Overview {
+	t.Helper()
+	o, err := Parse([]byte(s))
+	if err != nil {
+		t.Fatalf("Parse: %v\n%s", err, s)
+	}
+	return o
+}
+
+const miningPreset = `- - mining
+  - - - groups
+      - - 463
+`
+
+func TestMerge(t *testing.T) {
+	tests := []struct {
+		name          string
+		ours, theirs  string
+		wantConflicts []string
+		// check checks the merged overview.
+		check func(t *testing.T, o *Overview)
+	}{
+		{
+			name:   "groups merged as sets",
+			ours:   mergeEdit(t, "        - 26\n", "        - 26\n        - 27\n"),
+			theirs: mergeEdit(t, "      - - 25\n        - 26\n", "      - - 26\n"),
+			check: func(t *testing.T, o *Overview) {
+				if got, want := o.Preset("pvp").groups(), []InvGroupId{26, 27}; !reflect.DeepEqual(got, want) {
+					t.Errorf("pvp groups = %v, want %v", got, want)
+				}
+			},
+		},
+		{
+			name:   "both add the same group",
+			ours:   mergeEdit(t, "        - 26\n", "        - 26\n        - 27\n"),
+			theirs: mergeEdit(t, "        - 26\n", "        - 26\n        - 27\n"),
+			check: func(t *testing.T, o *Overview) {
+				if got, want := o.Preset("pvp").groups(), []InvGroupId{25, 26, 27}; !reflect.DeepEqual(got, want) {
+					t.Errorf("pvp groups = %v, want %v", got, want)
+				}
+			},
+		},
+		{
+			name:   "unchanged preset deleted",
+			ours:   mergeBaseYAML,
+			theirs: mergeEdit(t, miningPreset, ""),
+			check: func(t *testing.T, o *Overview) {
+				if o.Preset("mining") != nil {
+					t.Errorf("deleted preset kept")
+				}
+			},
+		},
+		{
+			name:          "modified preset deleted",
+			ours:          mergeEdit(t, "      - - 463\n", "      - - 463\n        - 464\n"),
+			theirs:        mergeEdit(t, miningPreset, ""),
+			wantConflicts: []string{`presets(mining): ours (modified), theirs (deleted) (base (present))`},
+			check: func(t *testing.T, o *Overview) {
+				if got, want := o.Preset("mining").groups(), []InvGroupId{463, 464}; !reflect.DeepEqual(got, want) {
+					t.Errorf("mining groups = %v, want %v", got, want)
+				}
+			},
+		},
+		{
+			name:          "deleted preset modified",
+			ours:          mergeEdit(t, miningPreset, ""),
+			theirs:        mergeEdit(t, "      - - 463\n", "      - - 463\n        - 464\n"),
+			wantConflicts: []string{`presets(mining): ours (deleted), theirs (modified) (base (present))`},
+			check: func(t *testing.T, o *Overview) {
+				if o.Preset("mining") == nil {
+					t.Errorf("modified preset dropped")
+				}
+			},
+		},
+		{
+			name:   "preset added by them",
+			ours:   mergeBaseYAML,
+			theirs: mergeEdit(t, "tabSetup:\n", "- - pve\n  - - - groups\n      - - 27\ntabSetup:\n"),
+			check: func(t *testing.T, o *Overview) {
+				if o.Preset("pve") == nil {
+					t.Errorf("added preset missing")
+				}
+			},
+		},
+		{
+			name:   "tab attribute changed by one side",
+			ours:   mergeEdit(t, "      - PvP\n", "      - PvP!\n"),
+			theirs: mergeEdit(t, "      - pvp\n", "      - mining\n"),
+			check: func(t *testing.T, o *Overview) {
+				ts := o.TabSetup[0]
+				if ts.Name != "PvP!" || ts.Overview != "mining" {
+					t.Errorf("tab = %q/%q, want PvP!/mining", ts.Name, ts.Overview)
+				}
+			},
+		},
+		{
+			name:          "tab attribute conflict",
+			ours:          mergeEdit(t, "      - pvp\n", "      - mining\n"),
+			theirs:        mergeEdit(t, "      - pvp\n", "      - pve\n"),
+			wantConflicts: []string{`tabSetup(0).overview: ours "mining", theirs "pve" (base "pvp")`},
+			check: func(t *testing.T, o *Overview) {
+				if got := o.TabSetup[0].Overview; got != "mining" {
+					t.Errorf("tab overview = %q, want ours", got)
+				}
+			},
+		},
+		{
+			name:          "ordered list conflict",
+			ours:          mergeEdit(t, "- ICON\n- DISTANCE\n", "- DISTANCE\n- ICON\n"),
+			theirs:        mergeEdit(t, "- DISTANCE\n", "- DISTANCE\n- NAME\n"),
+			wantConflicts: []string{`columnOrder: ours [DISTANCE, ICON], theirs [ICON, DISTANCE, NAME] (base [ICON, DISTANCE])`},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			o, conflicts := Merge(mustParse(t, mergeBaseYAML), mustParse(t, test.ours),
+				mustParse(t, test.theirs))
+			var got []string
+			for _, c := range conflicts {
+				got = append(got, c.String())
+			}
+			if !reflect.DeepEqual(got, test.wantConflicts) {
+				t.Errorf("conflicts = %q, want %q", got, test.wantConflicts)
+			}
+			if test.check != nil {
+				test.check(t, o)
+			}
+		})
+	}
+}
+
+func TestMarkConflicts(t *testing.T) {
+	c := testCatalog(t)
+	ours := mergeEdit(t, "      - pvp\n", "      - mining # ours\n")
+	theirs := mergeEdit(t, "      - pvp\n", "      - pve\n")
+	o, conflicts := Merge(mustParse(t, mergeBaseYAML), mustParse(t, ours), mustParse(t, theirs))
+	o.MarkConflicts(conflicts)
+	out := marshalLF(t, o, c)
+	marker := `# CONFLICT: ours "mining", theirs "pve" (base "pvp")`
+	if lineWith(out, marker) != marker {
+		t.Errorf("missing conflict marker %q in output:\n%s", marker, out)
+	}
+	if want := "- mining # ours"; lineWith(out, "- mining #") != want {
+		t.Errorf("comment on conflicting element lost, want %q in output:\n%s", want, out)
+	}
+}
@@ -0,0 +1,241 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overview
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+const (
+	DefaultGroupComment  = "{{.Category}} ({{.CategoryID}}) -- {{.Name}}"
+	DefaultStateComment  = "{{.Name}}"
+	DefaultColumnComment = "{{.Name}}"
+)
+
+// OutputOptions controls how overviews (and group snippets) are written.
+type OutputOptions struct {
+	// CRLF ends lines with "\r\n", as the Eve client does, rather than "\n".
+	CRLF bool
+	// Indent is the number of spaces per indentation level.
+	Indent int
+	// BOM starts the output with a UTF-8 byte order mark.
+	BOM bool
+	// GroupComment, StateComment and ColumnComment are text/template
+	// templates for the annotation comments, executed with an
+	// AnnotationData. IDs that aren't in the catalog are always annotated
+	// with "Unknown ...".
+	GroupComment  string
+	StateComment  string
+	ColumnComment string
+	// DescribeGroups, if set, adds a comment above the groups of each preset
+	// with a category-relative description of them (see DescribeGroups),
+	// using these as the groups of each category.
+	DescribeGroups AllGroups
+}
+
+// DefaultOutputOptions returns the options used by Marshal.
+func DefaultOutputOptions() OutputOptions {
+	return OutputOptions{
+		CRLF:          true,
+		Indent:        2,
+		GroupComment:  DefaultGroupComment,
+		StateComment:  DefaultStateComment,
+		ColumnComment: DefaultColumnComment,
+	}
+}
+
+// AnnotationData is what the annotation comment templates are executed with.
+type AnnotationData struct {
+	// ID is the annotated value, e.g. "25" or "DISTANCE".
+	ID string
+	// Name is the name of the group, state or column, e.g. "Frigate".
+	Name string
+	// Category and CategoryID are the inventory category of a group, e.g.
+	// "Ship" and 6. They are empty for states and columns.
+	Category   string
+	CategoryID int
+}
+
+// annotator renders annotation comments from the templates in OutputOptions.
+type annotator struct {
+	cat    *Catalog
+	group  *template.Template
+	state  *template.Template
+	column *template.Template
+	// describe are the groups to describe preset groups relative to, if set.
+	describe AllGroups
+	// err is the first error from executing a template.
+	err error
+}
+
+func newAnnotator(c *Catalog, opts OutputOptions) (*annotator, error) {
+	a := &annotator{cat: c, describe: opts.DescribeGroups}
+	var err error
+	if a.group, err = parseTemplate("group", opts.GroupComment, DefaultGroupComment); err != nil {
+		return nil, err
+	}
+	if a.state, err = parseTemplate("state", opts.StateComment, DefaultStateComment); err != nil {
+		return nil, err
+	}
+	if a.column, err = parseTemplate("column", opts.ColumnComment, DefaultColumnComment); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+func parseTemplate(name, text, def string) (*template.Template, error) {
+	if text == "" {
+		text = def
+	}
+	t, err := template.New(name).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("Bad %s comment template: %v", name, err)
+	}
+	return t, nil
+}
+
+func (a *annotator) exec(t *template.Template, data AnnotationData) string {
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		if a.err == nil {
+			a.err = fmt.Errorf("Executing %s comment template: %v", t.Name(), err)
+		}
+		return ""
+	}
+	// Comments have to stay on one line.
+	return strings.Join(strings.Fields(buf.String()), " ")
+}
+
+func (a *annotator) groupComment(ig InvGroupId) string {
+	g, ok := a.cat.Groups[ig]
+	if !ok {
+		return a.cat.GroupName(ig)
+	}
+	return a.exec(a.group, AnnotationData{
+		ID:         fmt.Sprintf("%d", int(ig)),
+		Name:       strings.TrimSpace(g.Name),
+		Category:   a.cat.CategoryName(g.Cat),
+		CategoryID: int(g.Cat),
+	})
+}
+
+func (a *annotator) stateComment(st StateType) string {
+	if _, ok := a.cat.States[st]; !ok {
+		return a.cat.StateName(st)
+	}
+	return a.exec(a.state, AnnotationData{ID: fmt.Sprintf("%d", int(st)), Name: a.cat.StateName(st)})
+}
+
+func (a *annotator) columnComment(col Column) string {
+	if _, ok := a.cat.Columns[col]; !ok {
+		return a.cat.ColumnName(col)
+	}
+	return a.exec(a.column, AnnotationData{ID: string(col), Name: a.cat.ColumnName(col)})
+}
+
+// groupSpecPrefix starts each line of a generated description of a preset's
+// groups.
+const groupSpecPrefix = "# groups: "
+
+// groupsComment returns the description of a preset's groups, as a comment,
+// or "" if they aren't being described.
+func (a *annotator) groupsComment(igs []InvGroupId) string {
+	if a.describe == nil {
+		return ""
+	}
+	var lines []string
+	for _, line := range DescribeGroups(igs, a.describe, a.cat).Lines(a.cat) {
+		lines = append(lines, groupSpecPrefix+line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// templateDirectives are the prefixes of the comment lines at the top of a
+// file that record the annotation templates it was written with, if they
+// weren't the defaults, e.g. "# eot:group-comment {{.Name}}". They let the
+// annotations be recognised as generated when the file is next written, even
+// with different templates.
+var templateDirectives = []struct {
+	prefix string
+	field  func(opts *OutputOptions) *string
+}{
+	{"# eot:group-comment ", func(opts *OutputOptions) *string { return &opts.GroupComment }},
+	{"# eot:state-comment ", func(opts *OutputOptions) *string { return &opts.StateComment }},
+	{"# eot:column-comment ", func(opts *OutputOptions) *string { return &opts.ColumnComment }},
+}
+
+// templateComment returns the comment recording the templates in opts that
+// aren't the defaults, or "" if there are none.
+func (opts OutputOptions) templateComment() string {
+	def := DefaultOutputOptions()
+	var lines []string
+	for _, td := range templateDirectives {
+		if t := *td.field(&opts); t != "" && t != *td.field(&def) {
+			lines = append(lines, td.prefix+strings.Join(strings.Fields(t), " "))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// isTemplateDirective returns true if line records an annotation template.
+func isTemplateDirective(line string) bool {
+	for _, td := range templateDirectives {
+		if strings.HasPrefix(line, td.prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// finish applies the line ending and BOM options to yaml output.
+func (opts OutputOptions) finish(b []byte) []byte {
+	if opts.CRLF {
+		b = bytes.Replace(b, []byte("\n"), []byte("\r\n"), -1)
+	}
+	if opts.BOM {
+		b = append([]byte("\xef\xbb\xbf"), b...)
+	}
+	return b
+}
+
+func (opts OutputOptions) indent() int {
+	if opts.Indent <= 0 {
+		return 2
+	}
+	return opts.Indent
+}
+
+// GroupSnippet returns a yaml list of the given groups, annotated as in an
+// overview, and indented to the depth of a preset's group list so it can be
+// pasted straight into an overview file. No BOM is added.
+func GroupSnippet(igs []InvGroupId, c *Catalog, opts OutputOptions) ([]byte, error) {
+	a, err := newAnnotator(c, opts)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	prefix := strings.Repeat(" ", 4*opts.indent())
+	for _, ig := range igs {
+		fmt.Fprintf(&buf, "%s- %d # %s\n", prefix, int(ig), a.groupComment(ig))
+	}
+	if a.err != nil {
+		return nil, a.err
+	}
+	opts.BOM = false
+	return opts.finish(buf.Bytes()), nil
+}
@@ -0,0 +1,323 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overview
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"reflect"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Parse parses an overview yaml export. Any top-level sections that aren't
+// recognised are kept as-is in Overview.Extra, and any comments are kept so
+// they can be written out again by Marshal. Errors are returned as
+// *Diagnostic.
+func Parse(b []byte) (*Overview, error) {
+	o, err := parse(b, &decoder{})
+	if err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+type ParseOptions struct {
+	// Filename is used as the file name in diagnostics.
+	Filename string
+	// KeepGoing makes the parser record every problem it finds, skipping the
+	// entries that are broken, instead of stopping at the first one.
+	KeepGoing bool
+	// Strict makes unknown sections and preset/tab attributes errors. By
+	// default they are kept in the Extra fields, passed through unchanged by
+	// Marshal, and reported as warnings.
+	Strict bool
+}
+
+// ParseWithOptions parses an overview yaml export, and returns it along with
+// any problems found, ordered by their position in the file. Unless the file
+// isn't valid yaml, the returned Overview is never nil, even if there are
+// errors.
+func ParseWithOptions(b []byte, opts ParseOptions) (*Overview, Diagnostics) {
+	d := &decoder{file: opts.Filename, keepGoing: opts.KeepGoing, strict: opts.Strict}
+	o, err := parse(b, d)
+	if err != nil {
+		d.diags = append(d.diags, err.(*Diagnostic))
+	}
+	if o != nil {
+		d.diags = append(d.diags, o.Unrecognised()...)
+	}
+	d.diags.sort()
+	return o, d.diags
+}
+
+// ParseFile reads and parses the named overview file.
+func ParseFile(name string) (*Overview, error) {
+	b, err := ioutil.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	o, err := parse(b, &decoder{file: name})
+	if err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+func parse(b []byte, d *decoder) (*Overview, error) {
+	// The yaml parser attaches comments inconsistently when lines end in
+	// CRLF, which is what the Eve client (and Marshal) write.
+	b = bytes.Replace(b, []byte("\r\n"), []byte("\n"), -1)
+	var doc yaml.Node
+	if err := yaml.Unmarshal(b, &doc); err != nil {
+		return nil, d.syntaxError(err)
+	}
+	o := &Overview{file: d.file, comments: collectComments(&doc), directives: collectDirectives(&doc)}
+	if len(doc.Content) == 0 {
+		// Empty document.
+		return o, nil
+	}
+	err := o.decode(d, doc.Content[0])
+	o.positions = d.positions
+	return o, err
+}
+
+var sectionNames = []string{
+	"backgroundOrder", "backgroundStates", "columnOrder", "flagOrder", "flagStates",
+	"overviewColumns", "presets", "shipLabelOrder", "shipLabels", "stateBlinks",
+	"stateColorsNameList", "tabSetup", "userSettings",
+}
+
+type Overview struct {
+	BackgroundOrder     []StateType
+	BackgroundStates    []StateType
+	ColumnOrder         []Column
+	FlagOrder           []StateType
+	FlagStates          []StateType
+	OverviewColumns     []Column
+	Presets             []*Preset
+	ShipLabelOrder      []NullableString
+	ShipLabels          []*ShipLabel
+	StateBlinks         []*StateBlink
+	StateColorsNameList []*StateColorName
+	TabSetup            []*TabSetup
+	UserSettings        []*UserSetting
+	// Extra holds the top-level sections this package doesn't know about, so
+	// they can be written back out unchanged.
+	Extra []RawAttr
+	// file is the name of the parsed file, if known.
+	file string
+	// order is the order of the top-level sections in the parsed file.
+	order []string
+	// comments are the comments from the parsed file.
+	comments comments
+	// positions are the positions of the elements in the parsed file, keyed
+	// by path.
+	positions map[string]Position
+	// directives are the inline lint directives from the parsed file.
+	directives directives
+}
+
+func (o *Overview) decode(d *decoder, root *yaml.Node) error {
+	root = resolveAlias(root)
+	if root.Kind != yaml.MappingNode {
+		return d.errorf(root, "", "overview is not a mapping (%s)", kindName(root))
+	}
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		key, val := root.Content[i].Value, root.Content[i+1]
+		o.order = append(o.order, key)
+		d.mark(root.Content[i], key)
+		var err error
+		switch key {
+		case "backgroundOrder":
+			o.BackgroundOrder, err = d.states(val, key)
+		case "backgroundStates":
+			o.BackgroundStates, err = d.states(val, key)
+		case "columnOrder":
+			o.ColumnOrder, err = d.columns(val, key)
+		case "flagOrder":
+			o.FlagOrder, err = d.states(val, key)
+		case "flagStates":
+			o.FlagStates, err = d.states(val, key)
+		case "overviewColumns":
+			o.OverviewColumns, err = d.columns(val, key)
+		case "presets":
+			err = d.list(val, key, func(n *yaml.Node, path string) error {
+				p := &Preset{}
+				if err := p.decode(d, n, path); err != nil {
+					return err
+				}
+				o.Presets = append(o.Presets, p)
+				return nil
+			})
+		case "shipLabelOrder":
+			var ss []string
+			ss, err = d.strs(val, key)
+			for _, s := range ss {
+				o.ShipLabelOrder = append(o.ShipLabelOrder, NullableString(s))
+			}
+		case "shipLabels":
+			err = d.list(val, key, func(n *yaml.Node, path string) error {
+				sl := &ShipLabel{}
+				if err := sl.decode(d, n, path); err != nil {
+					return err
+				}
+				o.ShipLabels = append(o.ShipLabels, sl)
+				return nil
+			})
+		case "stateBlinks":
+			err = d.list(val, key, func(n *yaml.Node, path string) error {
+				sb := &StateBlink{}
+				if err := sb.decode(d, n, path); err != nil {
+					return err
+				}
+				o.StateBlinks = append(o.StateBlinks, sb)
+				return nil
+			})
+		case "stateColorsNameList":
+			err = d.list(val, key, func(n *yaml.Node, path string) error {
+				sc := &StateColorName{}
+				if err := sc.decode(d, n, path); err != nil {
+					return err
+				}
+				o.StateColorsNameList = append(o.StateColorsNameList, sc)
+				return nil
+			})
+		case "tabSetup":
+			err = d.list(val, key, func(n *yaml.Node, path string) error {
+				ts := &TabSetup{}
+				if err := ts.decode(d, n, path); err != nil {
+					return err
+				}
+				o.TabSetup = append(o.TabSetup, ts)
+				return nil
+			})
+		case "userSettings":
+			err = d.list(val, key, func(n *yaml.Node, path string) error {
+				us := &UserSetting{}
+				if err := us.decode(d, n, path); err != nil {
+					return err
+				}
+				o.UserSettings = append(o.UserSettings, us)
+				return nil
+			})
+		default:
+			err = d.unknown(&o.Extra, "top-level section", key, val, key)
+		}
+		if err != nil && !d.recover(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// Unrecognised returns a warning for every section and attribute that was
+// carried through without being understood.
+func (o *Overview) Unrecognised() []*Diagnostic {
+	var out []*Diagnostic
+	for _, ra := range o.Extra {
+		out = append(out, ra.unrecognised(o.file, "top-level section"))
+	}
+	for _, p := range o.Presets {
+		for _, ra := range p.Extra {
+			out = append(out, ra.unrecognised(o.file, fmt.Sprintf("preset %+q attribute", p.Name)))
+		}
+	}
+	for _, ts := range o.TabSetup {
+		for _, ra := range ts.Extra {
+			out = append(out, ra.unrecognised(o.file, fmt.Sprintf("tabSetup %d attribute", ts.Id)))
+		}
+	}
+	return out
+}
+
+func (o *Overview) marshal(e *encoder) (*yaml.Node, error) {
+	vals := map[string]interface{}{
+		"backgroundOrder":     e.states(o.BackgroundOrder),
+		"backgroundStates":    e.states(o.BackgroundStates),
+		"columnOrder":         e.columns(o.ColumnOrder),
+		"flagOrder":           e.states(o.FlagOrder),
+		"flagStates":          e.states(o.FlagStates),
+		"overviewColumns":     e.columns(o.OverviewColumns),
+		"presets":             e.presets(o.Presets),
+		"shipLabelOrder":      o.ShipLabelOrder,
+		"shipLabels":          o.ShipLabels,
+		"stateBlinks":         e.stateBlinks(o.StateBlinks),
+		"stateColorsNameList": e.stateColors(o.StateColorsNameList),
+		"tabSetup":            o.TabSetup,
+		"userSettings":        o.UserSettings,
+	}
+	for _, ra := range o.Extra {
+		vals[ra.Name] = ra.Value
+	}
+	root := &yaml.Node{Kind: yaml.MappingNode}
+	for _, key := range attrOrder(o.order, sectionNames, o.Extra) {
+		if o.order != nil && !isKnown(key, o.order) && isEmpty(vals[key]) {
+			// Don't add empty sections that weren't in the original file.
+			continue
+		}
+		val, err := toNode(vals[key])
+		if err != nil {
+			return nil, fmt.Errorf("Section %+q: %v", key, err)
+		}
+		root.Content = append(root.Content,
+			&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}, val)
+	}
+	return root, nil
+}
+
+func isEmpty(v interface{}) bool {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Invalid:
+		return true
+	case reflect.Slice, reflect.Map:
+		return rv.Len() == 0
+	}
+	return false
+}
+
+type StateType int
+
+type ShipLabelState int
+
+func (ss ShipLabelState) name() string {
+	switch ss {
+	case 0:
+		return "Disabled"
+	case 1:
+		return "Enabled"
+	default:
+		return "Unknown ShipLabelState"
+	}
+}
+
+func (ss ShipLabelState) String() string {
+	return fmt.Sprintf("%s (%d)", ss.name(), int(ss))
+}
+
+func (ss ShipLabelState) MarshalYAML() (interface{}, error) {
+	return annotated(int(ss), ss.name()), nil
+}
+
+type NullableString string
+
+func (ns NullableString) MarshalYAML() (interface{}, error) {
+	if len(ns) == 0 {
+		return nil, nil
+	}
+	return string(ns), nil
+}
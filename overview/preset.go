@@ -0,0 +1,129 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overview
+
+import (
+	"gopkg.in/yaml.v3"
+)
+
+var presetAttrs = []string{"alwaysShownStates", "filteredStates", "groups"}
+
+type Preset struct {
+	Name              string
+	AlwaysShownStates *PresetStates
+	FilteredStates    *PresetStates
+	Groups            *PresetGroups
+	// Extra holds the attributes this package doesn't know about.
+	Extra []RawAttr
+	// order is the order of the attributes in the parsed file.
+	order []string
+}
+
+func (p *Preset) marshal(e *encoder) interface{} {
+	vals := make(map[string]interface{})
+	if p.AlwaysShownStates != nil {
+		vals["alwaysShownStates"] = p.AlwaysShownStates.marshal(e)
+	}
+	if p.FilteredStates != nil {
+		vals["filteredStates"] = p.FilteredStates.marshal(e)
+	}
+	if p.Groups != nil {
+		vals["groups"] = p.Groups.marshal(e)
+	}
+	for _, ra := range p.Extra {
+		vals[ra.Name] = []interface{}{ra.Name, ra.Value}
+	}
+	attrs := []interface{}{}
+	for _, name := range attrOrder(p.order, presetAttrs, p.Extra) {
+		if v, ok := vals[name]; ok {
+			attrs = append(attrs, v)
+		}
+	}
+	return []interface{}{p.Name, attrs}
+}
+
+func (p *Preset) decode(d *decoder, n *yaml.Node, path string) error {
+	entries, err := d.seq(n, path, 2)
+	if err != nil {
+		return err
+	}
+	if p.Name, err = d.str(entries[0], path); err != nil {
+		return err
+	}
+	return d.list(entries[1], path, func(attr *yaml.Node, attrPath string) error {
+		name, val, err := d.pair(attr, attrPath)
+		if err != nil {
+			return err
+		}
+		p.order = append(p.order, name)
+		attrPath = path + "." + name
+		d.mark(val, attrPath)
+		if !isKnown(name, presetAttrs) {
+			return d.unknown(&p.Extra, "Preset attribute", name, val, attrPath)
+		}
+		ns, err := d.ints(val, attrPath)
+		if err != nil {
+			return err
+		}
+		switch name {
+		case "alwaysShownStates":
+			p.AlwaysShownStates = newPresetState(name, ns)
+		case "filteredStates":
+			p.FilteredStates = newPresetState(name, ns)
+		case "groups":
+			p.Groups = newPresetGroup(ns)
+		}
+		return nil
+	})
+}
+
+type PresetStates struct {
+	Name   string
+	States []StateType
+}
+
+func newPresetState(name string, ns []int) *PresetStates {
+	ps := &PresetStates{Name: name}
+	ps.States = make([]StateType, len(ns))
+	for i, n := range ns {
+		ps.States[i] = StateType(n)
+	}
+	return ps
+}
+
+func (ps *PresetStates) marshal(e *encoder) interface{} {
+	return []interface{}{ps.Name, e.states(ps.States)}
+}
+
+type PresetGroups struct {
+	Groups []InvGroupId
+}
+
+func newPresetGroup(ns []int) *PresetGroups {
+	pg := &PresetGroups{Groups: make([]InvGroupId, len(ns))}
+	for i, n := range ns {
+		pg.Groups[i] = InvGroupId(n)
+	}
+	return pg
+}
+
+func (pg *PresetGroups) marshal(e *encoder) interface{} {
+	n := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq", Content: []*yaml.Node{
+		{Kind: yaml.ScalarNode, Tag: "!!str", Value: "groups"},
+		{Kind: yaml.SequenceNode, Tag: "!!seq", Content: e.groups(pg.Groups)},
+	}}
+	n.HeadComment = e.groupsComment(pg.Groups)
+	return n
+}
@@ -0,0 +1,297 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overview
+
+import (
+	"sort"
+	"strings"
+)
+
+// FindGroups returns the inventory groups whose names best match query.
+// Matches are tried in order of how close they are: the exact name, then a
+// name starting with query, containing it, containing all of its words, and
+// finally containing its letters in order. Case is ignored, and group names
+// are matched both on their own and with their category, e.g. "Ship (6) --
+// Frigate".
+func (c *Catalog) FindGroups(query string) []InvGroupId {
+	out, _ := c.searchGroups(query, nil)
+	return out
+}
+
+// FindStates returns the filter states whose names best match query, in the
+// same way as FindGroups.
+func (c *Catalog) FindStates(query string) []StateType {
+	out, _ := c.searchStates(query)
+	return out
+}
+
+// Search returns the groups in ag and the filter states whose names best
+// match query, as for FindGroups. Groups and states are ranked together, so
+// e.g. an exact state name hides groups that only contain query, and the
+// loosest matches are only used if nothing else matches.
+func (c *Catalog) Search(query string, ag AllGroups) ([]InvGroupId, []StateType) {
+	igs, gScore := c.searchGroups(query, ag)
+	sts, sScore := c.searchStates(query)
+	switch {
+	case gScore > sScore:
+		sts = nil
+	case sScore > gScore:
+		igs = nil
+	}
+	return igs, sts
+}
+
+// searchGroups returns the groups that best match query, limited to those in
+// ag if it is set, and their score.
+func (c *Catalog) searchGroups(query string, ag AllGroups) ([]InvGroupId, int) {
+	var ids []InvGroupId
+	var names [][]string
+	for id, g := range c.Groups {
+		if ag != nil && !ag.Has(id, c) {
+			continue
+		}
+		ids = append(ids, id)
+		names = append(names, []string{g.Name, c.GroupName(id)})
+	}
+	var out []InvGroupId
+	matches, score := bestMatches(query, names)
+	for _, i := range matches {
+		out = append(out, ids[i])
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out, score
+}
+
+func (c *Catalog) searchStates(query string) ([]StateType, int) {
+	var ids []StateType
+	var names [][]string
+	for id, name := range c.States {
+		ids = append(ids, id)
+		names = append(names, []string{name})
+	}
+	var out []StateType
+	matches, score := bestMatches(query, names)
+	for _, i := range matches {
+		out = append(out, ids[i])
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out, score
+}
+
+// bestMatches returns the indexes of the entries of candidates (each a list
+// of alternative names) that match query most closely, and their score (see
+// matchScore), or -1 if none match.
+func bestMatches(query string, candidates [][]string) ([]int, int) {
+	query = strings.ToLower(strings.TrimSpace(query))
+	if query == "" {
+		return nil, -1
+	}
+	best := -1
+	var out []int
+	for i, names := range candidates {
+		score := -1
+		for _, name := range names {
+			if s := matchScore(query, strings.ToLower(strings.TrimSpace(name))); s > score {
+				score = s
+			}
+		}
+		switch {
+		case score < 0 || score < best:
+		case score > best:
+			best, out = score, []int{i}
+		default:
+			out = append(out, i)
+		}
+	}
+	return out, best
+}
+
+// matchScore returns how closely name matches query, from 4 for an exact
+// match down to 0, or -1 if it doesn't match.
+func matchScore(query, name string) int {
+	switch {
+	case name == query:
+		return 4
+	case strings.HasPrefix(name, query):
+		return 3
+	case strings.Contains(name, query):
+		return 2
+	}
+	words := strings.Fields(query)
+	all := len(words) > 1
+	for _, w := range words {
+		if !strings.Contains(name, w) {
+			all = false
+			break
+		}
+	}
+	if all {
+		return 1
+	}
+	// Subsequence match, e.g. "mdepot" for "mobile depot".
+	i := 0
+	for _, r := range name {
+		if i < len(query) && rune(query[i]) == r {
+			i++
+		}
+	}
+	if i == len(query) {
+		return 0
+	}
+	return -1
+}
+
+// GroupUsage describes where an inventory group is shown in an overview.
+type GroupUsage struct {
+	Group InvGroupId `json:"group"`
+	Name  string     `json:"name"`
+	// Presets are the presets that include the group.
+	Presets []*GroupPreset `json:"presets"`
+	// Missing are the names of the presets that don't include the group.
+	Missing []string    `json:"missing"`
+	Tabs    []*TabUsage `json:"tabs"`
+}
+
+// GroupPreset is a preset that includes a group.
+type GroupPreset struct {
+	Name string `json:"name"`
+	// Filtered are the states that hide pilots in the group, and
+	// AlwaysShown the ones that show them regardless.
+	Filtered    []Item `json:"filtered,omitempty"`
+	AlwaysShown []Item `json:"alwaysShown,omitempty"`
+}
+
+// TabUsage describes whether a tab's overview and bracket presets show a
+// group, or what they do with a state ("filtered", "always shown", "always
+// shown, also filtered" or "").
+type TabUsage struct {
+	Id             int    `json:"id"`
+	Name           string `json:"name"`
+	Overview       string `json:"overview"`
+	OverviewResult string `json:"overviewResult"`
+	Bracket        string `json:"bracket,omitempty"`
+	BracketResult  string `json:"bracketResult,omitempty"`
+}
+
+// QueryGroup returns where group ig is shown in o.
+func QueryGroup(o *Overview, c *Catalog, ig InvGroupId) *GroupUsage {
+	gu := &GroupUsage{Group: ig, Name: c.GroupName(ig)}
+	for _, p := range o.Presets {
+		if !p.hasGroup(ig) {
+			gu.Missing = append(gu.Missing, p.Name)
+			continue
+		}
+		gu.Presets = append(gu.Presets, &GroupPreset{
+			Name:        p.Name,
+			Filtered:    stateItems(p.filtered(), c),
+			AlwaysShown: stateItems(p.alwaysShown(), c),
+		})
+	}
+	gu.Tabs = o.tabUsage(func(p *Preset) string {
+		if p.hasGroup(ig) {
+			return "shown"
+		}
+		return "not shown"
+	})
+	return gu
+}
+
+// StateUsage describes what the presets in an overview do with a state.
+type StateUsage struct {
+	State StateType `json:"state"`
+	Name  string    `json:"name"`
+	// AlwaysShown and Filtered are the names of the presets that always show,
+	// or filter out, pilots in the state. A preset that has the state in
+	// both lists is in both, and in Conflicting.
+	AlwaysShown []string `json:"alwaysShown"`
+	Filtered    []string `json:"filtered"`
+	// Conflicting are the presets that both always show and filter out the
+	// state. The client always shows it.
+	Conflicting []string    `json:"conflicting,omitempty"`
+	Tabs        []*TabUsage `json:"tabs"`
+}
+
+// QueryState returns what the presets in o do with state st.
+func QueryState(o *Overview, c *Catalog, st StateType) *StateUsage {
+	su := &StateUsage{State: st, Name: c.StateName(st)}
+	for _, p := range o.Presets {
+		always, filtered := hasState(p.alwaysShown(), st), hasState(p.filtered(), st)
+		if always {
+			su.AlwaysShown = append(su.AlwaysShown, p.Name)
+		}
+		if filtered {
+			su.Filtered = append(su.Filtered, p.Name)
+		}
+		if always && filtered {
+			su.Conflicting = append(su.Conflicting, p.Name)
+		}
+	}
+	su.Tabs = o.tabUsage(func(p *Preset) string { return p.stateResult(st) })
+	return su
+}
+
+func (p *Preset) hasGroup(ig InvGroupId) bool {
+	for _, g := range p.groups() {
+		if g == ig {
+			return true
+		}
+	}
+	return false
+}
+
+func hasState(sts []StateType, st StateType) bool {
+	for _, s := range sts {
+		if s == st {
+			return true
+		}
+	}
+	return false
+}
+
+// stateResult returns what the preset does with pilots in state st. Always
+// shown states take priority over filtered ones.
+func (p *Preset) stateResult(st StateType) string {
+	always, filtered := hasState(p.alwaysShown(), st), hasState(p.filtered(), st)
+	switch {
+	case always && filtered:
+		return "always shown, also filtered"
+	case always:
+		return "always shown"
+	case filtered:
+		return "filtered"
+	}
+	return ""
+}
+
+// tabUsage describes every tab, using result to describe its presets.
+func (o *Overview) tabUsage(result func(*Preset) string) []*TabUsage {
+	describe := func(name string) string {
+		p := o.Preset(name)
+		if p == nil {
+			return "missing preset"
+		}
+		return result(p)
+	}
+	var out []*TabUsage
+	for _, ts := range o.TabSetup {
+		tu := &TabUsage{Id: ts.Id, Name: ts.Name, Overview: ts.Overview,
+			OverviewResult: describe(ts.Overview)}
+		if ts.Bracket != "" {
+			tu.Bracket, tu.BracketResult = string(ts.Bracket), describe(string(ts.Bracket))
+		}
+		out = append(out, tu)
+	}
+	return out
+}
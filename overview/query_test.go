@@ -0,0 +1,52 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overview
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestQueryStateConflict(t *testing.T) {
+	o := mustParse(t, `presets:
+- - both
+  - - - alwaysShownStates
+      - [11]
+    - - filteredStates
+      - [11]
+- - hide
+  - - - filteredStates
+      - [11]
+tabSetup:
+- - 0
+  - - - name
+      - Both
+    - - overview
+      - both
+`)
+	su := QueryState(o, testCatalog(t), 11)
+	if want := []string{"both"}; !reflect.DeepEqual(su.AlwaysShown, want) {
+		t.Errorf("AlwaysShown = %q, want %q", su.AlwaysShown, want)
+	}
+	if want := []string{"both", "hide"}; !reflect.DeepEqual(su.Filtered, want) {
+		t.Errorf("Filtered = %q, want %q", su.Filtered, want)
+	}
+	if want := []string{"both"}; !reflect.DeepEqual(su.Conflicting, want) {
+		t.Errorf("Conflicting = %q, want %q", su.Conflicting, want)
+	}
+	if got, want := su.Tabs[0].OverviewResult, "always shown, also filtered"; got != want {
+		t.Errorf("tab result = %+q, want %+q", got, want)
+	}
+}
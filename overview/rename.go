@@ -0,0 +1,83 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overview
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RenamePreset renames a preset, and updates the tabs that use it as their
+// overview or bracket preset. Comments attached to the preset or the tab
+// references are kept. It fails if there's no preset named from, or there
+// already is one named to. The tabs that were updated are returned.
+func (o *Overview) RenamePreset(from, to string) ([]*TabSetup, error) {
+	if to == "" {
+		return nil, fmt.Errorf("new preset name is empty")
+	}
+	p := o.Preset(from)
+	if p == nil {
+		return nil, fmt.Errorf("no preset named %+q", from)
+	}
+	if o.Preset(to) != nil {
+		return nil, fmt.Errorf("preset %+q already exists", to)
+	}
+	p.Name = to
+	path := ".presets(" + to + ")"
+	o.comments.rename(".presets("+from+")", path)
+	// The name itself is also part of the path of its own node.
+	o.comments.rename(path+"="+from, path+"="+to)
+	var tabs []*TabSetup
+	for _, ts := range o.TabSetup {
+		updated := false
+		if ts.Overview == from {
+			ts.Overview = to
+			updated = true
+		}
+		if string(ts.Bracket) == from {
+			ts.Bracket = NullableString(to)
+			updated = true
+		}
+		if updated {
+			tabs = append(tabs, ts)
+		}
+	}
+	return tabs, nil
+}
+
+// rename moves the comments of the node at path from, and of every node
+// under it, to path to.
+func (cs comments) rename(from, to string) {
+	cs.move(func(path string) (string, bool) {
+		if !strings.HasPrefix(path, from) {
+			return "", false
+		}
+		return to + path[len(from):], true
+	})
+}
+
+// move moves the comments for which f returns a new path.
+func (cs comments) move(f func(path string) (string, bool)) {
+	moved := make(comments)
+	for path, nc := range cs {
+		if newPath, ok := f(path); ok {
+			delete(cs, path)
+			moved[newPath] = nc
+		}
+	}
+	for path, nc := range moved {
+		cs[path] = nc
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overview
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// GroupReplacements maps the IDs of inventory groups that CCP has retired or
+// merged to the groups that replaced them. An empty list means the group was
+// removed without a successor.
+type GroupReplacements map[InvGroupId][]InvGroupId
+
+// loadReplacements loads the group replacements CSV file. Each record is a
+// group ID, and a space-separated list of the IDs that replaced it. No map is
+// embedded in the package, so none are loaded if path is empty.
+func loadReplacements(path string) (GroupReplacements, error) {
+	if path == "" {
+		return GroupReplacements{}, nil
+	}
+	reader, err := loadFile(path, "")
+	if err != nil {
+		return nil, fmt.Errorf("Unable to load group replacements CSV file: %v", err)
+	}
+	records, err := loadCsvEntries(reader, 2)
+	if err != nil {
+		return nil, err
+	}
+	gr := make(GroupReplacements, len(records))
+	for i, record := range records {
+		if i == 0 && record[0] == "groupID" {
+			// Skip the header line, if present.
+			continue
+		}
+		id, err := strconv.Atoi(strings.TrimSpace(record[0]))
+		if err != nil {
+			return nil, err
+		}
+		igs := []InvGroupId{}
+		for _, f := range strings.Fields(record[1]) {
+			n, err := strconv.Atoi(f)
+			if err != nil {
+				return nil, fmt.Errorf("group %d: %v", id, err)
+			}
+			igs = append(igs, InvGroupId(n))
+		}
+		gr[InvGroupId(id)] = igs
+	}
+	return gr, nil
+}
+
+// UnknownGroup is a group in a preset that isn't in the inventory groups
+// data.
+type UnknownGroup struct {
+	Preset string
+	Group  InvGroupId
+	// Replacements are the groups that replaced it, if known.
+	Replacements []InvGroupId
+	// Known is set if the group is in the replacements data, even if it was
+	// removed without a successor.
+	Known bool
+}
+
+// UnknownGroups returns the groups in each preset that aren't in the
+// inventory groups data, along with their replacements.
+func UnknownGroups(o *Overview, c *Catalog) []*UnknownGroup {
+	var out []*UnknownGroup
+	for _, p := range o.Presets {
+		for _, ig := range p.groups() {
+			if _, ok := c.Groups[ig]; ok {
+				continue
+			}
+			rs, known := c.Replacements[ig]
+			out = append(out, &UnknownGroup{Preset: p.Name, Group: ig, Replacements: rs, Known: known})
+		}
+	}
+	return out
+}
+
+// FixUnknownGroups replaces the unknown groups in each preset with the groups
+// that replaced them. Groups without known replacements are removed if drop
+// is set, otherwise they are left in place. The unknown groups found are
+// returned.
+func FixUnknownGroups(o *Overview, c *Catalog, drop bool) []*UnknownGroup {
+	ugs := UnknownGroups(o, c)
+	for _, p := range o.Presets {
+		if p.Groups == nil {
+			continue
+		}
+		// Replacements are only added if the preset doesn't already have
+		// them, but the rest of the preset is left as it is.
+		has := make(map[InvGroupId]bool)
+		for _, ig := range p.Groups.Groups {
+			has[ig] = true
+		}
+		var igs []InvGroupId
+		for _, ig := range p.Groups.Groups {
+			if _, ok := c.Groups[ig]; ok {
+				igs = append(igs, ig)
+				continue
+			}
+			rs, known := c.Replacements[ig]
+			if !known && !drop {
+				igs = append(igs, ig)
+			}
+			for _, r := range rs {
+				if !has[r] {
+					igs = append(igs, r)
+					has[r] = true
+				}
+			}
+		}
+		p.Groups.Groups = igs
+	}
+	return ugs
+}
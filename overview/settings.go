@@ -12,10 +12,10 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package main
+package overview
 
 import (
-	"fmt"
+	"gopkg.in/yaml.v3"
 )
 
 type UserSetting struct {
@@ -27,23 +27,12 @@ func (us *UserSetting) MarshalYAML() (interface{}, error) {
 	return []interface{}{us.Name, us.Val}, nil
 }
 
-func (us *UserSetting) UnmarshalYAML(f func(interface{}) error) error {
-	var v []interface{}
+func (us *UserSetting) decode(d *decoder, n *yaml.Node, path string) error {
+	var val *yaml.Node
 	var err error
-	if err = f(&v); err != nil {
+	if us.Name, val, err = d.pair(n, path); err != nil {
 		return err
 	}
-	if len(v) != 2 {
-		return fmt.Errorf(
-			"UserSetting has wrong number of entries (Expected: 2 Got: %d): %+q", len(v), v)
-	}
-	us.Name, err = intfToString(v[0])
-	if err != nil {
-		return fmt.Errorf("UserSetting name: %v", err)
-	}
-	us.Val, err = intfToBool(v[1])
-	if err != nil {
-		return fmt.Errorf("UserSetting (%+q) value: %v", us.Name, err)
-	}
-	return nil
+	us.Val, err = d.boolean(val, path)
+	return err
 }
@@ -12,10 +12,10 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package main
+package overview
 
 import (
-	"fmt"
+	"gopkg.in/yaml.v3"
 )
 
 type ShipLabel struct {
@@ -36,42 +36,32 @@ func (sl *ShipLabel) MarshalYAML() (interface{}, error) {
 	return []interface{}{sl.Name, attrs}, nil
 }
 
-func (sl *ShipLabel) UnmarshalYAML(f func(interface{}) error) error {
-	var v []interface{}
-	var err error
-	if err = f(&v); err != nil {
+func (sl *ShipLabel) decode(d *decoder, n *yaml.Node, path string) error {
+	entries, err := d.seq(n, path, 2)
+	if err != nil {
 		return err
 	}
-	if len(v) != 2 {
-		return fmt.Errorf(
-			"ShipLabel has wrong number of entries (Expected: 2 Got: %d): %+q", len(v), v)
-	}
-	name, err := intfToString(v[0])
+	name, err := d.str(entries[0], path)
 	if err != nil {
-		return fmt.Errorf("ShipLabel name: %v", err)
+		return err
 	}
 	sl.Name = NullableString(name)
-	attrList, err := intfTointfSlice(v[1], 0)
-	if err != nil {
-		return fmt.Errorf("ShipLabel (%+q) attribute list: %v", sl.Name, err)
-	}
-	for _, attr := range attrList {
-		name, attrVal, err := parseAttr(attr)
+	return d.list(entries[1], path, func(attr *yaml.Node, attrPath string) error {
+		name, val, err := d.pair(attr, attrPath)
 		if err != nil {
-			return fmt.Errorf("ShipLabel (%+q) has bad attribute: %s", sl.Name, err)
+			return err
 		}
+		attrPath = path + "." + name
+		d.mark(val, attrPath)
 		if name == "state" {
-			n, err := intfToInt(attrVal)
-			if err != nil {
-				return fmt.Errorf("ShipLabel (%+q) state attribute: %s", sl.Name, err)
-			}
+			n, err := d.integer(val, attrPath)
 			sl.State = ShipLabelState(n)
-			continue
+			return err
 		}
 		// Otherwise it's a string.
-		s, err := intfToString(attrVal)
+		s, err := d.str(val, attrPath)
 		if err != nil {
-			return fmt.Errorf("ShipLabel (%+q) %+q attribute: %s", sl.Name, name, err)
+			return err
 		}
 		switch name {
 		case "post":
@@ -81,8 +71,8 @@ func (sl *ShipLabel) UnmarshalYAML(f func(interface{}) error) error {
 		case "type":
 			sl.Type = NullableString(s)
 		default:
-			return fmt.Errorf("ShipLabel (%+q) has unknown attribute: %+q", sl.Name, name)
+			return d.errorf(attr, attrPath, "unknown ShipLabel attribute %+q", name)
 		}
-	}
-	return nil
+		return nil
+	})
 }
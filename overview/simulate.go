@@ -0,0 +1,100 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overview
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Visibility describes whether the presets and tabs of an overview show an
+// entity, e.g. a pilot in a ship of a given group with a set of states.
+type Visibility struct {
+	// Type is the inventory type of the entity, if it was given as a type
+	// rather than a group.
+	Type    *Item               `json:"type,omitempty"`
+	Group   InvGroupId          `json:"group"`
+	Name    string              `json:"name"`
+	States  []Item              `json:"states,omitempty"`
+	Presets []*PresetVisibility `json:"presets"`
+	Tabs    []*TabUsage         `json:"tabs"`
+}
+
+// PresetVisibility is whether a preset shows the entity, and why.
+type PresetVisibility struct {
+	Preset string `json:"preset"`
+	Shown  bool   `json:"shown"`
+	Reason string `json:"reason"`
+}
+
+// Simulate returns whether each preset and tab of o shows an entity of group
+// ig with states sts. As in the client, an entity with any of a preset's
+// always shown states is shown, whatever its group. Otherwise it's shown if
+// its group is in the preset, unless it has any of the filtered states.
+func Simulate(o *Overview, c *Catalog, ig InvGroupId, sts []StateType) *Visibility {
+	v := &Visibility{Group: ig, Name: c.GroupName(ig), States: stateItems(sts, c)}
+	for _, p := range o.Presets {
+		shown, reason := p.visibility(ig, sts, c)
+		v.Presets = append(v.Presets, &PresetVisibility{Preset: p.Name, Shown: shown, Reason: reason})
+	}
+	v.Tabs = o.tabUsage(func(p *Preset) string {
+		if shown, _ := p.visibility(ig, sts, c); shown {
+			return "shown"
+		}
+		return "not shown"
+	})
+	return v
+}
+
+// SimulateType is like Simulate, for an entity of inventory type it.
+func SimulateType(o *Overview, c *Catalog, it InvTypeId, sts []StateType) (*Visibility, error) {
+	t, ok := c.Types[it]
+	if !ok {
+		return nil, fmt.Errorf("unknown inventory type %d", int(it))
+	}
+	v := Simulate(o, c, t.Group, sts)
+	v.Type = &Item{ID: int(it), Name: c.TypeName(it)}
+	return v, nil
+}
+
+// visibility returns whether p shows an entity of group ig with states sts,
+// and why.
+func (p *Preset) visibility(ig InvGroupId, sts []StateType, c *Catalog) (bool, string) {
+	if always := matchingStates(p.alwaysShown(), sts, c); len(always) > 0 {
+		return true, "always shown: " + strings.Join(always, ", ")
+	}
+	if !p.hasGroup(ig) {
+		return false, "group not in preset"
+	}
+	if filtered := matchingStates(p.filtered(), sts, c); len(filtered) > 0 {
+		return false, "filtered: " + strings.Join(filtered, ", ")
+	}
+	return true, "group in preset"
+}
+
+// matchingStates returns the descriptions of the states in sts that are also
+// in list.
+func matchingStates(list, sts []StateType, c *Catalog) []string {
+	var out []string
+	for _, st := range sts {
+		for _, s := range list {
+			if s == st {
+				out = append(out, c.State(st))
+				break
+			}
+		}
+	}
+	return out
+}
@@ -12,10 +12,10 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package main
+package overview
 
 import (
-	"fmt"
+	"gopkg.in/yaml.v3"
 )
 
 type StateBlink struct {
@@ -27,25 +27,14 @@ func (sb *StateBlink) MarshalYAML() (interface{}, error) {
 	return []interface{}{sb.Name, sb.Val}, nil
 }
 
-func (sb *StateBlink) UnmarshalYAML(f func(interface{}) error) error {
-	var v []interface{}
+func (sb *StateBlink) decode(d *decoder, n *yaml.Node, path string) error {
+	var val *yaml.Node
 	var err error
-	if err = f(&v); err != nil {
+	if sb.Name, val, err = d.pair(n, path); err != nil {
 		return err
 	}
-	if len(v) != 2 {
-		return fmt.Errorf(
-			"StateBlink has wrong number of entries (Expected: 2 Got: %d): %+q", len(v), v)
-	}
-	sb.Name, err = intfToString(v[0])
-	if err != nil {
-		return fmt.Errorf("StateBlink name: %v", err)
-	}
-	sb.Val, err = intfToBool(v[1])
-	if err != nil {
-		return fmt.Errorf("StateBlink (%+q) value: %v", sb.Name, err)
-	}
-	return nil
+	sb.Val, err = d.boolean(val, path)
+	return err
 }
 
 type StateColorName struct {
@@ -57,23 +46,12 @@ func (sc *StateColorName) MarshalYAML() (interface{}, error) {
 	return []interface{}{sc.Name, sc.Val}, nil
 }
 
-func (sc *StateColorName) UnmarshalYAML(f func(interface{}) error) error {
-	var v []interface{}
+func (sc *StateColorName) decode(d *decoder, n *yaml.Node, path string) error {
+	var val *yaml.Node
 	var err error
-	if err = f(&v); err != nil {
+	if sc.Name, val, err = d.pair(n, path); err != nil {
 		return err
 	}
-	if len(v) != 2 {
-		return fmt.Errorf(
-			"StateColorName has wrong number of entries (Expected: 2 Got: %d): %+q", len(v), v)
-	}
-	sc.Name, err = intfToString(v[0])
-	if err != nil {
-		return fmt.Errorf("StateColorName name: %v", err)
-	}
-	sc.Val, err = intfToString(v[1])
-	if err != nil {
-		return fmt.Errorf("StateColorName (%+q) value: %v", sc.Name, err)
-	}
-	return nil
+	sc.Val, err = d.str(val, path)
+	return err
 }
@@ -0,0 +1,142 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overview
+
+import (
+	"sort"
+)
+
+// Summary is a statistical summary of an overview.
+type Summary struct {
+	Presets          int              `json:"presets"`
+	Tabs             int              `json:"tabs"`
+	PresetStats      []*PresetSummary `json:"presetStats"`
+	UnusedPresets    []string         `json:"unusedPresets"`
+	BackgroundStates int              `json:"backgroundStates"`
+	FlagStates       int              `json:"flagStates"`
+	Columns          []Item           `json:"columns"`
+	ShipLabels       []*LabelSummary  `json:"shipLabels"`
+}
+
+// PresetSummary summarises a preset.
+type PresetSummary struct {
+	Name              string `json:"name"`
+	Groups            int    `json:"groups"`
+	AlwaysShownStates int    `json:"alwaysShownStates"`
+	FilteredStates    int    `json:"filteredStates"`
+	// Categories is the number of groups in each category, ordered by
+	// category ID.
+	Categories []*CategoryCount `json:"categories"`
+	// Tabs are the IDs of the tabs that use the preset.
+	Tabs []int `json:"tabs"`
+}
+
+// CategoryCount is the number of groups from an inventory category.
+type CategoryCount struct {
+	ID     InvCategoryId `json:"id"`
+	Name   string        `json:"name"`
+	Groups int           `json:"groups"`
+}
+
+// LabelSummary describes a ship label.
+type LabelSummary struct {
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Pre     string `json:"pre"`
+	Post    string `json:"post"`
+	Enabled bool   `json:"enabled"`
+}
+
+// unknownCategory is used to count groups that aren't in the catalog.
+const unknownCategory InvCategoryId = -1
+
+// Summarise returns a summary of o.
+func Summarise(o *Overview, c *Catalog) *Summary {
+	s := &Summary{
+		Presets:          len(o.Presets),
+		Tabs:             len(o.TabSetup),
+		BackgroundStates: len(o.BackgroundStates),
+		FlagStates:       len(o.FlagStates),
+	}
+	for _, p := range o.Presets {
+		ps := &PresetSummary{
+			Name:              p.Name,
+			Groups:            len(p.groups()),
+			AlwaysShownStates: len(p.alwaysShown()),
+			FilteredStates:    len(p.filtered()),
+			Categories:        categoryCounts(p.groups(), c),
+		}
+		for _, ts := range o.TabSetup {
+			if ts.Overview == p.Name || string(ts.Bracket) == p.Name {
+				ps.Tabs = append(ps.Tabs, ts.Id)
+			}
+		}
+		if len(ps.Tabs) == 0 {
+			s.UnusedPresets = append(s.UnusedPresets, p.Name)
+		}
+		s.PresetStats = append(s.PresetStats, ps)
+	}
+	s.Columns = columnItems(o.enabledColumns(), c)
+	for _, sl := range o.ShipLabels {
+		s.ShipLabels = append(s.ShipLabels, &LabelSummary{
+			Name:    sl.Name.key(),
+			Type:    sl.Type.key(),
+			Pre:     sl.Pre,
+			Post:    sl.Post,
+			Enabled: sl.State == 1,
+		})
+	}
+	return s
+}
+
+func categoryCounts(igs []InvGroupId, c *Catalog) []*CategoryCount {
+	counts := make(map[InvCategoryId]int)
+	for _, ig := range igs {
+		cat := unknownCategory
+		if g, ok := c.Groups[ig]; ok {
+			cat = g.Cat
+		}
+		counts[cat]++
+	}
+	var out []*CategoryCount
+	for cat, n := range counts {
+		out = append(out, &CategoryCount{ID: cat, Name: c.CategoryName(cat), Groups: n})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	return out
+}
+
+// enabledColumns returns the enabled columns, in the order they are shown.
+func (o *Overview) enabledColumns() []Column {
+	enabled := make(map[Column]bool)
+	for _, col := range o.OverviewColumns {
+		enabled[col] = true
+	}
+	var out []Column
+	for _, col := range o.ColumnOrder {
+		if enabled[col] {
+			out = append(out, col)
+			delete(enabled, col)
+		}
+	}
+	// Enabled columns missing from columnOrder go at the end.
+	for _, col := range o.OverviewColumns {
+		if enabled[col] {
+			out = append(out, col)
+			delete(enabled, col)
+		}
+	}
+	return out
+}
@@ -0,0 +1,109 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overview
+
+import (
+	"gopkg.in/yaml.v3"
+)
+
+var tabAttrs = []string{"bracket", "name", "overview", "showAll", "showNone", "showSpecials"}
+
+type TabSetup struct {
+	Id           int
+	Bracket      NullableString
+	Name         string
+	Overview     string
+	ShowAll      *bool
+	ShowNone     *bool
+	ShowSpecials *bool
+	// Extra holds the attributes this package doesn't know about.
+	Extra []RawAttr
+	// order is the order of the attributes in the parsed file.
+	order []string
+}
+
+func (ts *TabSetup) MarshalYAML() (interface{}, error) {
+	vals := map[string]interface{}{
+		"bracket":  ts.Bracket,
+		"name":     ts.Name,
+		"overview": ts.Overview,
+	}
+	if ts.ShowAll != nil {
+		vals["showAll"] = ts.ShowAll
+	}
+	if ts.ShowNone != nil {
+		vals["showNone"] = ts.ShowNone
+	}
+	if ts.ShowSpecials != nil {
+		vals["showSpecials"] = ts.ShowSpecials
+	}
+	for _, ra := range ts.Extra {
+		vals[ra.Name] = ra.Value
+	}
+	attrs := [][]interface{}{}
+	for _, name := range attrOrder(ts.order, tabAttrs, ts.Extra) {
+		if v, ok := vals[name]; ok {
+			attrs = append(attrs, []interface{}{name, v})
+		}
+	}
+	return []interface{}{ts.Id, attrs}, nil
+}
+
+func (ts *TabSetup) decode(d *decoder, n *yaml.Node, path string) error {
+	entries, err := d.seq(n, path, 2)
+	if err != nil {
+		return err
+	}
+	if ts.Id, err = d.integer(entries[0], path); err != nil {
+		return err
+	}
+	return d.list(entries[1], path, func(attr *yaml.Node, attrPath string) error {
+		name, val, err := d.pair(attr, attrPath)
+		if err != nil {
+			return err
+		}
+		ts.order = append(ts.order, name)
+		attrPath = path + "." + name
+		d.mark(val, attrPath)
+		var b bool
+		switch name {
+		case "bracket":
+			s, err := d.str(val, attrPath)
+			if err != nil {
+				return err
+			}
+			ts.Bracket = NullableString(s)
+		case "name":
+			ts.Name, err = d.str(val, attrPath)
+		case "overview":
+			ts.Overview, err = d.str(val, attrPath)
+		case "showAll":
+			if b, err = d.boolean(val, attrPath); err == nil {
+				ts.ShowAll = &b
+			}
+		case "showNone":
+			if b, err = d.boolean(val, attrPath); err == nil {
+				ts.ShowNone = &b
+			}
+		case "showSpecials":
+			if b, err = d.boolean(val, attrPath); err == nil {
+				ts.ShowSpecials = &b
+			}
+		default:
+			err = d.unknown(&ts.Extra, "TabSetup attribute", name, val, attrPath)
+		}
+		return err
+	})
+}
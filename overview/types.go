@@ -0,0 +1,104 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overview
+
+import (
+	"encoding/csv"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const invTypePathUrl = "https://www.fuzzwork.co.uk/dump/latest/invTypes.csv.bz2"
+
+type InvTypeId int
+
+// InvType is an inventory type, e.g. the "Rifter" ship, which is in the
+// "Frigate" group.
+type InvType struct {
+	Id    InvTypeId
+	Group InvGroupId
+	Name  string
+}
+
+// loadTypes loads the inventory types CSV file, as published at
+// invTypePathUrl. As it's large, it isn't embedded in the package, and no
+// types are loaded if path is empty.
+func loadTypes(path string) (map[InvTypeId]*InvType, error) {
+	if path == "" {
+		return nil, nil
+	}
+	reader, err := loadFile(path, "")
+	if err != nil {
+		return nil, fmt.Errorf("Unable to load inventory types CSV file: %v", err)
+	}
+	csvr := csv.NewReader(reader)
+	// Descriptions contain newlines and stray quotes, and the number of
+	// columns has changed between SDE releases.
+	csvr.FieldsPerRecord = -1
+	csvr.LazyQuotes = true
+	records, err := csvr.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("Unable to load inventory types CSV file: %v", err)
+	}
+	m := make(map[InvTypeId]*InvType, len(records))
+	for i, record := range records {
+		if len(record) < 3 {
+			return nil, fmt.Errorf("inventory types CSV file: record %d is too short", i+1)
+		}
+		id, err := strconv.Atoi(record[0])
+		if err != nil {
+			if i == 0 {
+				// Skip the header line, if present.
+				continue
+			}
+			return nil, err
+		}
+		ig, err := strconv.Atoi(record[1])
+		if err != nil {
+			return nil, err
+		}
+		m[InvTypeId(id)] = &InvType{Id: InvTypeId(id), Group: InvGroupId(ig), Name: record[2]}
+	}
+	return m, nil
+}
+
+// TypeName returns the name of the inventory type, e.g. "Rifter".
+func (c *Catalog) TypeName(it InvTypeId) string {
+	t, ok := c.Types[it]
+	if !ok {
+		return "Unknown InvType"
+	}
+	return strings.TrimSpace(t.Name)
+}
+
+// FindTypes returns the inventory types whose names best match query, in the
+// same way as FindGroups.
+func (c *Catalog) FindTypes(query string) []InvTypeId {
+	var ids []InvTypeId
+	var names [][]string
+	for id, t := range c.Types {
+		ids = append(ids, id)
+		names = append(names, []string{t.Name})
+	}
+	var out []InvTypeId
+	matches, _ := bestMatches(query, names)
+	for _, i := range matches {
+		out = append(out, ids[i])
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
@@ -0,0 +1,47 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overview
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Validate checks the overview against the catalog with every lint rule, and
+// returns any problems found.
+func Validate(o *Overview, c *Catalog) Diagnostics {
+	return Lint(o, c, nil)
+}
+
+// Pos returns the position in the parsed file of the element at path (e.g.
+// "presets[4].groups[12]"). If that element has no recorded position, the
+// position of the closest enclosing element is returned instead.
+func (o *Overview) Pos(path string) Position {
+	for path != "" {
+		if pos, ok := o.positions[path]; ok {
+			return pos
+		}
+		i := strings.LastIndexAny(path, ".[")
+		if i < 0 {
+			break
+		}
+		path = path[:i]
+	}
+	return Position{File: o.file}
+}
+
+func (o *Overview) diagf(sev Severity, path, format string, a ...interface{}) *Diagnostic {
+	return &Diagnostic{Severity: sev, Pos: o.Pos(path), Path: path, Message: fmt.Sprintf(format, a...)}
+}
@@ -0,0 +1,171 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/kormat/eve-overview-tool/overview"
+)
+
+var queryCmd = &command{
+	name: "query",
+	args: "FILE QUERY...",
+	short: "Show which presets and tabs show a group, by ID or name, or what " +
+		"they do with a state.",
+	setup: func(fs *flag.FlagSet) func([]string) error {
+		var cf catalogFlags
+		var pf parseFlags
+		var af allGroupsFlags
+		cf.register(fs)
+		pf.register(fs)
+		af.register(fs)
+		state := fs.Bool("state", false, "Look up a filter state instead of a group")
+		jsonOut := fs.Bool("json", false, "Write the result as JSON")
+		return func(args []string) error {
+			if len(args) < 2 {
+				return usagef("expected an overview file and a query")
+			}
+			query := strings.Join(args[1:], " ")
+			cat, err := cf.load()
+			if err != nil {
+				return err
+			}
+			o, err := pf.load(args[0])
+			if err != nil {
+				return err
+			}
+			var groups []overview.InvGroupId
+			var states []overview.StateType
+			if n, err := strconv.Atoi(query); err == nil {
+				if *state {
+					states = append(states, overview.StateType(n))
+				} else {
+					groups = append(groups, overview.InvGroupId(n))
+				}
+			} else if *state {
+				states = cat.FindStates(query)
+			} else {
+				ag, err := af.load(cat)
+				if err != nil {
+					return err
+				}
+				groups, states = cat.Search(query, ag)
+			}
+			if len(groups) == 0 && len(states) == 0 {
+				return fmt.Errorf("no groups or states match %+q", query)
+			}
+			var results []interface{}
+			for _, ig := range groups {
+				results = append(results, overview.QueryGroup(o, cat, ig))
+			}
+			for _, st := range states {
+				results = append(results, overview.QueryState(o, cat, st))
+			}
+			if *jsonOut {
+				enc := json.NewEncoder(os.Stdout)
+				enc.SetIndent("", "  ")
+				return enc.Encode(results)
+			}
+			for i, r := range results {
+				if i > 0 {
+					fmt.Println()
+				}
+				switch r := r.(type) {
+				case *overview.GroupUsage:
+					writeGroupUsage(os.Stdout, r)
+				case *overview.StateUsage:
+					writeStateUsage(os.Stdout, r)
+				}
+			}
+			return nil
+		}
+	},
+}
+
+func itemNames(its []overview.Item) string {
+	names := make([]string, len(its))
+	for i, it := range its {
+		names[i] = fmt.Sprintf("%s (%v)", it.Name, it.ID)
+	}
+	return strings.Join(names, ", ")
+}
+
+func orNone(ss []string) string {
+	if len(ss) == 0 {
+		return "(none)"
+	}
+	return strings.Join(ss, ", ")
+}
+
+func writeGroupUsage(w io.Writer, gu *overview.GroupUsage) {
+	fmt.Fprintf(w, "Group %d: %s\n", int(gu.Group), gu.Name)
+	if len(gu.Presets) == 0 {
+		fmt.Fprintf(w, "  Not in any preset.\n")
+	} else {
+		fmt.Fprintf(w, "  In presets:\n")
+		for _, gp := range gu.Presets {
+			fmt.Fprintf(w, "    %s\n", gp.Name)
+			if len(gp.Filtered) > 0 {
+				fmt.Fprintf(w, "      hidden if: %s\n", itemNames(gp.Filtered))
+			}
+			if len(gp.AlwaysShown) > 0 {
+				fmt.Fprintf(w, "      always shown if: %s\n", itemNames(gp.AlwaysShown))
+			}
+		}
+		fmt.Fprintf(w, "  Not in presets: %s\n", orNone(gu.Missing))
+	}
+	writeTabUsage(w, gu.Tabs)
+}
+
+func writeStateUsage(w io.Writer, su *overview.StateUsage) {
+	fmt.Fprintf(w, "State %d: %s\n", int(su.State), su.Name)
+	fmt.Fprintf(w, "  Always shown by: %s\n", orNone(su.AlwaysShown))
+	fmt.Fprintf(w, "  Filtered out by: %s\n", orNone(su.Filtered))
+	if len(su.Conflicting) > 0 {
+		fmt.Fprintf(w, "  Both always shown and filtered out by (always shown wins): %s\n",
+			strings.Join(su.Conflicting, ", "))
+	}
+	writeTabUsage(w, su.Tabs)
+}
+
+func writeTabUsage(w io.Writer, tus []*overview.TabUsage) {
+	if len(tus) == 0 {
+		return
+	}
+	fmt.Fprintf(w, "  Tabs:\n")
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	describe := func(preset, result string) string {
+		if preset == "" {
+			return "-"
+		}
+		if result == "" {
+			result = "no effect"
+		}
+		return fmt.Sprintf("%s (%s)", preset, result)
+	}
+	for _, tu := range tus {
+		fmt.Fprintf(tw, "    %d\t%s\toverview: %s\tbracket: %s\n", tu.Id, tu.Name,
+			describe(tu.Overview, tu.OverviewResult), describe(tu.Bracket, tu.BracketResult))
+	}
+	tw.Flush()
+}
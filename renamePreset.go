@@ -0,0 +1,70 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"flag"
+	"log"
+
+	"github.com/kormat/eve-overview-tool/overview"
+)
+
+var renamePresetCmd = &command{
+	name:  "rename-preset",
+	args:  "FILE OLD NEW",
+	short: "Rename a preset, and update the tabs that use it.",
+	setup: func(fs *flag.FlagSet) func([]string) error {
+		var cf catalogFlags
+		var pf parseFlags
+		var of outputFlags
+		var wf writeFlags
+		cf.register(fs)
+		pf.register(fs)
+		of.register(fs)
+		wf.register(fs)
+		return func(args []string) error {
+			if len(args) != 3 {
+				return usagef("expected an overview file and the old and new preset names")
+			}
+			if err := wf.check(); err != nil {
+				return err
+			}
+			opts, err := of.options(true)
+			if err != nil {
+				return err
+			}
+			cat, err := cf.load()
+			if err != nil {
+				return err
+			}
+			o, err := pf.load(args[0])
+			if err != nil {
+				return err
+			}
+			tabs, err := o.RenamePreset(args[1], args[2])
+			if err != nil {
+				return err
+			}
+			for _, ts := range tabs {
+				log.Printf("NOTE: updated tab %d (%s)", ts.Id, ts.Name)
+			}
+			b, err := overview.MarshalWithOptions(o, cat, opts)
+			if err != nil {
+				return err
+			}
+			return wf.write(args[0], b)
+		}
+	},
+}
@@ -0,0 +1,171 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/kormat/eve-overview-tool/overview"
+)
+
+var simulateCmd = &command{
+	name:  "simulate",
+	args:  "FILE GROUP [STATE...]",
+	short: "Show which presets and tabs show an entity of a group or type with a set of states.",
+	setup: func(fs *flag.FlagSet) func([]string) error {
+		var cf catalogFlags
+		var pf parseFlags
+		cf.register(fs)
+		pf.register(fs)
+		jsonOut := fs.Bool("json", false, "Write the result as JSON")
+		isType := fs.Bool("type", false,
+			"GROUP is an inventory type, e.g. a ship, looked up in the -types file")
+		return func(args []string) error {
+			if len(args) < 2 {
+				return usagef("expected an overview file and a group")
+			}
+			if *isType && cf.files.Types == "" {
+				return usagef("-type needs an inventory types file, given with -types")
+			}
+			cat, err := cf.load()
+			if err != nil {
+				return err
+			}
+			o, err := pf.load(args[0])
+			if err != nil {
+				return err
+			}
+			var sts []overview.StateType
+			for _, arg := range args[2:] {
+				st, err := findState(cat, arg)
+				if err != nil {
+					return err
+				}
+				sts = append(sts, st)
+			}
+			var v *overview.Visibility
+			if *isType {
+				it, err := findType(cat, args[1])
+				if err != nil {
+					return err
+				}
+				if v, err = overview.SimulateType(o, cat, it, sts); err != nil {
+					return err
+				}
+			} else {
+				ig, err := findGroup(cat, args[1])
+				if err != nil {
+					return err
+				}
+				v = overview.Simulate(o, cat, ig, sts)
+			}
+			if *jsonOut {
+				enc := json.NewEncoder(os.Stdout)
+				enc.SetIndent("", "  ")
+				return enc.Encode(v)
+			}
+			writeVisibility(os.Stdout, v)
+			return nil
+		}
+	},
+}
+
+// findGroup returns the group with the given ID, or the one whose name best
+// matches s.
+func findGroup(cat *overview.Catalog, s string) (overview.InvGroupId, error) {
+	if n, err := strconv.Atoi(s); err == nil {
+		return overview.InvGroupId(n), nil
+	}
+	igs := cat.FindGroups(s)
+	switch len(igs) {
+	case 0:
+		return 0, fmt.Errorf("no group matches %+q", s)
+	case 1:
+		return igs[0], nil
+	}
+	var names []string
+	for _, ig := range igs {
+		names = append(names, cat.Group(ig))
+	}
+	return 0, fmt.Errorf("%+q matches more than one group: %s", s, strings.Join(names, ", "))
+}
+
+// findType returns the inventory type with the given ID, or the one whose
+// name best matches s.
+func findType(cat *overview.Catalog, s string) (overview.InvTypeId, error) {
+	if n, err := strconv.Atoi(s); err == nil {
+		return overview.InvTypeId(n), nil
+	}
+	its := cat.FindTypes(s)
+	switch len(its) {
+	case 0:
+		return 0, fmt.Errorf("no type matches %+q", s)
+	case 1:
+		return its[0], nil
+	}
+	var names []string
+	for _, it := range its {
+		names = append(names, fmt.Sprintf("%s (%d)", cat.TypeName(it), int(it)))
+	}
+	return 0, fmt.Errorf("%+q matches more than one type: %s", s, strings.Join(names, ", "))
+}
+
+// findState returns the state with the given ID, or the one whose name best
+// matches s.
+func findState(cat *overview.Catalog, s string) (overview.StateType, error) {
+	if n, err := strconv.Atoi(s); err == nil {
+		return overview.StateType(n), nil
+	}
+	sts := cat.FindStates(s)
+	switch len(sts) {
+	case 0:
+		return 0, fmt.Errorf("no state matches %+q", s)
+	case 1:
+		return sts[0], nil
+	}
+	var names []string
+	for _, st := range sts {
+		names = append(names, cat.State(st))
+	}
+	return 0, fmt.Errorf("%+q matches more than one state: %s", s, strings.Join(names, ", "))
+}
+
+func writeVisibility(w io.Writer, v *overview.Visibility) {
+	if v.Type != nil {
+		fmt.Fprintf(w, "Type %v: %s\n", v.Type.ID, v.Type.Name)
+	}
+	fmt.Fprintf(w, "Group %d: %s\n", int(v.Group), v.Name)
+	if len(v.States) > 0 {
+		fmt.Fprintf(w, "  States: %s\n", itemNames(v.States))
+	}
+	fmt.Fprintf(w, "  Presets:\n")
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	for _, pv := range v.Presets {
+		result := "not shown"
+		if pv.Shown {
+			result = "shown"
+		}
+		fmt.Fprintf(tw, "    %s\t%s\t%s\n", pv.Preset, result, pv.Reason)
+	}
+	tw.Flush()
+	writeTabUsage(w, v.Tabs)
+}
@@ -0,0 +1,125 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/kormat/eve-overview-tool/overview"
+)
+
+var unknownGroupsCmd = &command{
+	name:  "unknown-groups",
+	args:  "FILE",
+	short: "List the unknown groups in each preset, and replace retired ones.",
+	setup: func(fs *flag.FlagSet) func([]string) error {
+		var cf catalogFlags
+		var pf parseFlags
+		var of outputFlags
+		var wf writeFlags
+		cf.register(fs)
+		pf.register(fs)
+		of.register(fs)
+		wf.register(fs)
+		fix := fs.Bool("fix", false, "Replace retired groups with their successors from -replacements, and write the result")
+		drop := fs.Bool("drop", false, "With -fix, also remove unknown groups that have no known replacement")
+		return func(args []string) error {
+			name, err := oneFile(args)
+			if err != nil {
+				return err
+			}
+			if err := wf.check(); err != nil {
+				return err
+			}
+			if *fix && !*drop && cf.files.Replacements == "" {
+				return usagef("-fix needs a group replacements file (-replacements), or -drop")
+			}
+			opts, err := of.options(true)
+			if err != nil {
+				return err
+			}
+			cat, err := cf.load()
+			if err != nil {
+				return err
+			}
+			o, err := pf.load(name)
+			if err != nil {
+				return err
+			}
+			if !*fix {
+				ugs := overview.UnknownGroups(o, cat)
+				writeUnknownGroups(os.Stdout, ugs, cat, false, false)
+				if len(ugs) > 0 {
+					return errSilent
+				}
+				return nil
+			}
+			ugs := overview.FixUnknownGroups(o, cat, *drop)
+			b, err := overview.MarshalWithOptions(o, cat, opts)
+			if err != nil {
+				return err
+			}
+			if err := wf.write(name, b); err != nil {
+				return err
+			}
+			// Keep the report out of the overview, if that's on stdout.
+			w := os.Stdout
+			if wf.toStdout() {
+				w = os.Stderr
+			}
+			writeUnknownGroups(w, ugs, cat, true, *drop)
+			return nil
+		}
+	},
+}
+
+// writeUnknownGroups writes the unknown groups of each preset, and what can be
+// done about them, or what was done if they were fixed.
+func writeUnknownGroups(w io.Writer, ugs []*overview.UnknownGroup, cat *overview.Catalog,
+	fixed, dropped bool) {
+	preset := ""
+	for i, ug := range ugs {
+		if i == 0 || ug.Preset != preset {
+			preset = ug.Preset
+			fmt.Fprintf(w, "%s:\n", preset)
+		}
+		var action string
+		switch {
+		case len(ug.Replacements) > 0:
+			var rs []string
+			for _, r := range ug.Replacements {
+				rs = append(rs, cat.Group(r))
+			}
+			action = "replace with " + strings.Join(rs, ", ")
+			if fixed {
+				action = "replaced with " + strings.Join(rs, ", ")
+			}
+		case ug.Known:
+			action = "retired with no replacement, remove"
+			if fixed {
+				action = "retired with no replacement, removed"
+			}
+		case fixed && dropped:
+			action = "no known replacement, removed"
+		default:
+			action = "no known replacement"
+		}
+		fmt.Fprintf(w, "  %d: %s\n", int(ug.Group), action)
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"path"
+	"strings"
+
+	"github.com/kormat/eve-overview-tool/overview"
+)
+
+const allGroupPreset = "all"
+
+var updateGroupsCmd = &command{
+	name:  "update-groups",
+	args:  "FILE",
+	short: "Update the per-category group lists using the 'All' preset in FILE.",
+	setup: func(fs *flag.FlagSet) func([]string) error {
+		var cf catalogFlags
+		var pf parseFlags
+		var of outputFlags
+		cf.register(fs)
+		pf.register(fs)
+		of.register(fs)
+		dir := fs.String("dir", "groups", "Directory to write the group lists to")
+		legacy := fs.String("f", "", "Overview file to use (deprecated, pass FILE instead)")
+		return func(args []string) error {
+			if *legacy != "" {
+				args = append([]string{*legacy}, args...)
+			}
+			name, err := oneFile(args)
+			if err != nil {
+				return err
+			}
+			opts, err := of.options(false)
+			if err != nil {
+				return err
+			}
+			cat, err := cf.load()
+			if err != nil {
+				return err
+			}
+			o, err := pf.load(name)
+			if err != nil {
+				return err
+			}
+			if err := updateGroups(o, cat, *dir, opts); err != nil {
+				return fmt.Errorf("unable to update %s/: %s", *dir, err)
+			}
+			return nil
+		}
+	},
+}
+
+func updateGroups(o *overview.Overview, cat *overview.Catalog, dir string,
+	opts overview.OutputOptions) error {
+	var p *overview.Preset
+	for i := range o.Presets {
+		if strings.ToLower(o.Presets[i].Name) == allGroupPreset {
+			p = o.Presets[i]
+			break
+		}
+	}
+	if p == nil || p.Groups == nil {
+		return fmt.Errorf("No 'All' preset found")
+	}
+	// Make a list of inventory group IDs per category.
+	cats := make(map[overview.InvCategoryId][]overview.InvGroupId)
+	for _, invG := range p.Groups.Groups {
+		ig, ok := cat.Groups[invG]
+		if !ok {
+			continue
+		}
+		cats[ig.Cat] = append(cats[ig.Cat], invG)
+	}
+	for c, invgs := range cats {
+		b, err := overview.GroupSnippet(invgs, cat, opts)
+		if err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(path.Join(dir, catToFilename(cat, c)), b, 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func catToFilename(cat *overview.Catalog, c overview.InvCategoryId) string {
+	n := strings.ToLower(cat.Categories[c])
+	return strings.Replace(n, " ", "_", -1) + ".yaml"
+}
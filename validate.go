@@ -0,0 +1,79 @@
+// Copyright 2017 Stephen Shirley
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/kormat/eve-overview-tool/overview"
+)
+
+var validateCmd = &command{
+	name:  "validate",
+	args:  "FILE...",
+	short: "Check overview files for problems, and report every one found.",
+	setup: func(fs *flag.FlagSet) func([]string) error {
+		var cf catalogFlags
+		var pf parseFlags
+		cf.register(fs)
+		pf.register(fs)
+		werror := fs.Bool("werror", false, "Treat warnings as errors")
+		return func(args []string) error {
+			if len(args) == 0 {
+				return usagef("no overview files given")
+			}
+			cat, err := cf.load()
+			if err != nil {
+				return err
+			}
+			return checkFiles(args, &pf, *werror, func(o *overview.Overview) overview.Diagnostics {
+				return overview.Validate(o, cat)
+			})
+		}
+	},
+}
+
+// checkFiles parses each of the named files, runs check over the ones
+// without errors, and prints every problem found. An error is returned if
+// there were any errors, or any warnings and werror is set.
+func checkFiles(names []string, pf *parseFlags, werror bool,
+	check func(*overview.Overview) overview.Diagnostics) error {
+	var errs, warns int
+	for _, name := range names {
+		o, diags, err := pf.parse(name)
+		if err != nil {
+			fmt.Printf("ERROR: %s\n", err)
+			errs++
+			continue
+		}
+		if o != nil && !diags.HasErrors() {
+			diags = append(diags, check(o)...)
+		}
+		for _, diag := range diags {
+			fmt.Printf("%s: %s\n", strings.ToUpper(diag.Severity.String()), diag)
+			if diag.Severity == overview.SevError {
+				errs++
+			} else {
+				warns++
+			}
+		}
+	}
+	if errs > 0 || (werror && warns > 0) {
+		return fmt.Errorf("found %d error(s) and %d warning(s)", errs, warns)
+	}
+	return nil
+}